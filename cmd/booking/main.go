@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/squ1ky/flyte/internal/booking/clients/grpc/flight"
+	"github.com/squ1ky/flyte/internal/booking/clients/grpc/user"
 	"github.com/squ1ky/flyte/internal/booking/config"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/domain/events"
 	bookinggrpc "github.com/squ1ky/flyte/internal/booking/handler/grpc"
 	"github.com/squ1ky/flyte/internal/booking/kafka"
 	"github.com/squ1ky/flyte/internal/booking/repository/pgrepo"
@@ -12,13 +15,19 @@ import (
 	"github.com/squ1ky/flyte/internal/booking/service/worker"
 	"github.com/squ1ky/flyte/pkg/bootstrap"
 	"github.com/squ1ky/flyte/pkg/db"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/leader"
 	"github.com/squ1ky/flyte/pkg/logger"
+	"github.com/squ1ky/flyte/pkg/outbox"
 	"github.com/squ1ky/flyte/pkg/shutdown"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"log/slog"
 	"net"
 	"os"
+	// Boarding passes carry the departure airport's local date, the image
+	// may not ship a time zone database.
+	_ "time/tzdata"
 )
 
 const migrationsPath = "migrations/booking"
@@ -53,15 +62,25 @@ func main() {
 		log.Error("failed to create flight service client", "error", err)
 	}
 
-	producer := kafka.NewPaymentEventProducer(cfg.Kafka, log)
-	defer func() {
-		if err := producer.Close(); err != nil {
-			log.Error("failed to close kafka producer", "error", err)
-		}
-	}()
+	userClient, err := user.NewClient(cfg.UserService.Address, cfg.GRPC.Timeout)
+	if err != nil {
+		log.Error("failed to create user service client", "error", err)
+	}
 
 	bookingRepo := pgrepo.NewBookingRepo(database)
-	bookingService := service.NewBookingService(bookingRepo, producer, flightClient, log)
+	serviceCfg := service.Config{
+		CheckInWindow: domain.CheckInWindow{
+			OpensBefore:  cfg.CheckIn.OpensBefore,
+			ClosesBefore: cfg.CheckIn.ClosesBefore,
+		},
+		TicketAirlineCode: cfg.Ticket.AirlineCode,
+		Saga: service.SagaConfig{
+			StepTimeout: cfg.Saga.StepTimeout,
+			BaseBackoff: cfg.Saga.BaseBackoff,
+			MaxBackoff:  cfg.Saga.MaxBackoff,
+		},
+	}
+	bookingService := service.NewBookingService(bookingRepo, flightClient, userClient, serviceCfg, log)
 
 	kafkaHandler := kafka.NewPaymentResultHandler(bookingService, log)
 	consumer := kafka.NewPaymentResultConsumer(cfg.Kafka, kafkaHandler, log)
@@ -71,6 +90,13 @@ func main() {
 		}
 	}()
 
+	flightConsumer := kafka.NewFlightEventConsumer(cfg.Kafka, bookingService, log)
+	defer func() {
+		if err := flightConsumer.Close(); err != nil {
+			log.Error("failed to close flight events consumer", "error", err)
+		}
+	}()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -81,10 +107,42 @@ func main() {
 		}
 	}()
 
-	outboxProcessor := worker.NewOutboxProcessor(bookingRepo, producer, log, cfg.Outbox.Interval)
-	cleaner := worker.NewExpiredBookingCleaner(bookingRepo, flightClient, log, cfg.Cleaner.Interval, cfg.Cleaner.BookingTTL)
-	go outboxProcessor.Start(ctx)
-	go cleaner.Start(ctx)
+	go func() {
+		if err := flightConsumer.Start(ctx); err != nil {
+			log.Error("flight events consumer stopped with error", "error", err)
+		}
+	}()
+
+	paymentSink := outbox.NewKafkaSink(cfg.Kafka.Brokers, cfg.Kafka.TopicRequests, worker.BookingKey, eventcodec.OutboxEncoder)
+	defer func() {
+		if err := paymentSink.Close(); err != nil {
+			log.Error("failed to close kafka producer", "error", err)
+		}
+	}()
+
+	sinks := outbox.Router{
+		events.EventTypePaymentRequest: paymentSink,
+		events.EventTypeRefundRequest:  paymentSink,
+
+		events.EventTypePaymentCaptureRequest: paymentSink,
+		events.EventTypePaymentVoidRequest:    paymentSink,
+	}
+	relayCfg := outbox.Config{
+		BatchSize: cfg.Outbox.BatchSize,
+		Interval:  cfg.Outbox.Interval,
+		Retry: outbox.RetryPolicy{
+			MaxAttempts: cfg.Outbox.MaxAttempts,
+			BaseBackoff: cfg.Outbox.BaseBackoff,
+			MaxBackoff:  cfg.Outbox.MaxBackoff,
+		},
+	}
+	outboxRelay := outbox.NewRelay(database, pgrepo.OutboxTable, sinks, relayCfg, log)
+	cleaner := worker.NewExpiredBookingCleaner(bookingRepo, log, cfg.Cleaner.Interval, cfg.Cleaner.BookingTTL)
+	sagaRecoverer := worker.NewSagaRecoverer(bookingService, log, cfg.Saga.Interval, cfg.Saga.BatchSize)
+	// Every worker runs on one replica only, elected per worker.
+	go leader.New(database, "booking-outbox-relay", cfg.Leader.Interval, log).Run(ctx, outboxRelay.Start)
+	go leader.New(database, "booking-expired-cleaner", cfg.Leader.Interval, log).Run(ctx, cleaner.Start)
+	go leader.New(database, "booking-saga-recoverer", cfg.Leader.Interval, log).Run(ctx, sagaRecoverer.Start)
 
 	grpcServerImpl := bookinggrpc.NewServer(bookingService, cfg.GRPC.Timeout)
 	grpcServer := grpc.NewServer()
@@ -0,0 +1,59 @@
+// Command dlq-redrive moves the messages of a dead-letter topic back to the
+// topics they came from, once the cause of the failures is fixed:
+//
+//	dlq-redrive -topic payment_requests.payment_service_group.dlq
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/squ1ky/flyte/pkg/kafkaretry"
+	"github.com/squ1ky/flyte/pkg/logger"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+func main() {
+	brokers := flag.String("brokers", envOr("KAFKA_BROKERS", "localhost:9092"), "comma separated kafka brokers")
+	topic := flag.String("topic", "", "dead-letter topic to drain")
+	group := flag.String("group", "dlq-redrive", "consumer group tracking what was re-driven")
+	limit := flag.Int("limit", 0, "maximum number of messages to re-drive, 0 for all")
+	idle := flag.Duration("idle", 10*time.Second, "stop after no message arrived for this long")
+	flag.Parse()
+
+	if *topic == "" {
+		fmt.Fprintln(os.Stderr, "-topic is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	log := logger.SetupLogger(envOr("ENV", "local"))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	moved, err := kafkaretry.Redrive(ctx, kafkaretry.RedriveConfig{
+		Brokers:     strings.Split(*brokers, ","),
+		Topic:       *topic,
+		GroupID:     *group,
+		Limit:       *limit,
+		IdleTimeout: *idle,
+	}, log)
+	if err != nil {
+		log.Error("re-drive failed", "topic", *topic, "moved", moved, "error", err)
+		os.Exit(1)
+	}
+
+	log.Info("re-drive finished", "topic", *topic, "moved", moved)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
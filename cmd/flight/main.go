@@ -5,6 +5,7 @@ import (
 	"fmt"
 	flightv1 "github.com/squ1ky/flyte/gen/go/flight"
 	"github.com/squ1ky/flyte/internal/flight/config"
+	"github.com/squ1ky/flyte/internal/flight/domain"
 	flightgrpc "github.com/squ1ky/flyte/internal/flight/handler/grpc"
 	"github.com/squ1ky/flyte/internal/flight/repository/elastic"
 	"github.com/squ1ky/flyte/internal/flight/repository/pgrepo"
@@ -12,7 +13,10 @@ import (
 	"github.com/squ1ky/flyte/internal/flight/service/worker"
 	"github.com/squ1ky/flyte/pkg/bootstrap"
 	"github.com/squ1ky/flyte/pkg/db"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/leader"
 	"github.com/squ1ky/flyte/pkg/logger"
+	"github.com/squ1ky/flyte/pkg/outbox"
 	"github.com/squ1ky/flyte/pkg/shutdown"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -64,10 +68,35 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	esSyncWorker := worker.NewElasticSyncWorker(database, flightRepo, esRepo, log)
-	seatCleaner := worker.NewSeatCleaner(database, log, cfg.Cleaner.Interval, cfg.Cleaner.ReservationTTL)
-	go esSyncWorker.Start(ctx)
-	go seatCleaner.Start(ctx)
+	eventSink := outbox.NewKafkaSink(cfg.Kafka.Brokers, cfg.Kafka.TopicEvents, worker.FlightKey, eventcodec.OutboxEncoder)
+	defer func() {
+		if err := eventSink.Close(); err != nil {
+			log.Error("failed to close kafka producer", "error", err)
+		}
+	}()
+
+	esSink := worker.NewElasticSink(flightRepo, esRepo)
+	sinks := outbox.Router{
+		string(domain.EventFlightCreated):   esSink,
+		string(domain.EventSeatsChanged):    esSink,
+		string(domain.EventFlightCancelled): outbox.Chain(esSink, eventSink),
+	}
+	relayCfg := outbox.Config{
+		BatchSize: cfg.Outbox.BatchSize,
+		Interval:  cfg.Outbox.Interval,
+		Retry: outbox.RetryPolicy{
+			MaxAttempts: cfg.Outbox.MaxAttempts,
+			BaseBackoff: cfg.Outbox.BaseBackoff,
+			MaxBackoff:  cfg.Outbox.MaxBackoff,
+		},
+	}
+	outboxRelay := outbox.NewRelay(database, pgrepo.OutboxTable, sinks, relayCfg, log)
+
+	seatCleaner := worker.NewSeatCleaner(database, outbox.NewWriter(pgrepo.OutboxTable), log,
+		cfg.Cleaner.Interval, cfg.Cleaner.ReservationTTL)
+	// Every worker runs on one replica only, elected per worker.
+	go leader.New(database, "flight-outbox-relay", cfg.Leader.Interval, log).Run(ctx, outboxRelay.Start)
+	go leader.New(database, "flight-seat-cleaner", cfg.Leader.Interval, log).Run(ctx, seatCleaner.Start)
 
 	grpcServerImpl := flightgrpc.NewServer(flightService, aircraftService)
 
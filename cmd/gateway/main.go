@@ -5,6 +5,7 @@ import (
 	"fmt"
 	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
 	flightv1 "github.com/squ1ky/flyte/gen/go/flight"
+	paymentv1 "github.com/squ1ky/flyte/gen/go/payment"
 	userv1 "github.com/squ1ky/flyte/gen/go/user"
 	"github.com/squ1ky/flyte/internal/gateway/config"
 	"github.com/squ1ky/flyte/internal/gateway/handler"
@@ -58,7 +59,20 @@ func main() {
 	bookingClient := bookingv1.NewBookingServiceClient(bookingConn)
 	log.Info("connected to booking service", slog.String("addr", cfg.Clients.BookingAddr))
 
+	// Payment Service
+	paymentConn, err := grpc.NewClient(cfg.Clients.PaymentAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("failed to connect to payment service", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	paymentClient := paymentv1.NewPaymentServiceClient(paymentConn)
+	log.Info("connected to payment service", slog.String("addr", cfg.Clients.PaymentAddr))
+
 	defer func() {
+		if err := paymentConn.Close(); err != nil {
+			log.Error("error closing payment conn", "error", err)
+		}
 		if err := bookingConn.Close(); err != nil {
 			log.Error("error closing booking conn", "error", err)
 		}
@@ -74,8 +88,9 @@ func main() {
 	userHandler := handler.NewUserHandler(userClient)
 	flightHandler := handler.NewFlightHandler(flightClient)
 	bookingHandler := handler.NewBookingHandler(bookingClient)
+	paymentHandler := handler.NewPaymentHandler(paymentClient)
 
-	gatewayHandler := handler.NewGatewayHandler(userHandler, flightHandler, bookingHandler)
+	gatewayHandler := handler.NewGatewayHandler(userHandler, flightHandler, bookingHandler, paymentHandler)
 
 	r := router.InitRoutes(gatewayHandler, userClient)
 	srv := httpserver.New(r, cfg.HTTP.Port)
@@ -5,14 +5,23 @@ import (
 	"errors"
 	"fmt"
 	"github.com/squ1ky/flyte/internal/payment/config"
+	paymentgrpc "github.com/squ1ky/flyte/internal/payment/handler/grpc"
 	"github.com/squ1ky/flyte/internal/payment/kafka"
+	"github.com/squ1ky/flyte/internal/payment/provider"
 	"github.com/squ1ky/flyte/internal/payment/repository/pgrepo"
 	"github.com/squ1ky/flyte/internal/payment/service"
+	"github.com/squ1ky/flyte/internal/payment/service/worker"
 	"github.com/squ1ky/flyte/pkg/bootstrap"
 	"github.com/squ1ky/flyte/pkg/db"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/leader"
 	"github.com/squ1ky/flyte/pkg/logger"
+	"github.com/squ1ky/flyte/pkg/outbox"
 	"github.com/squ1ky/flyte/pkg/shutdown"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 	"log/slog"
+	"net"
 	"os"
 )
 
@@ -43,17 +52,23 @@ func main() {
 	}
 	defer dbClose()
 
-	producer := kafka.NewPaymentProducer(cfg.Kafka, log)
-	defer func() {
-		if err := producer.Close(); err != nil {
-			log.Error("failed to close producer", "error", err)
-		}
-	}()
+	paymentProvider, err := provider.New(cfg.Provider, log)
+	if err != nil {
+		log.Error("init payment provider failed", "error", err)
+		os.Exit(1)
+	}
+	log.Info("payment provider selected", slog.String("provider", paymentProvider.Name()))
 
 	repo := pgrepo.NewPaymentRepo(database)
-	paymentService := service.NewPaymentService(repo, log)
+	serviceCfg := service.Config{
+		Recovery: service.RecoveryConfig{
+			StuckAfter:  cfg.Recovery.StuckAfter,
+			MaxAttempts: cfg.Recovery.MaxAttempts,
+		},
+	}
+	paymentService := service.NewPaymentService(repo, paymentProvider, serviceCfg, log)
 
-	handler := kafka.NewPaymentMessageHandler(paymentService, producer, log)
+	handler := kafka.NewPaymentMessageHandler(paymentService, log)
 	consumer := kafka.NewPaymentConsumer(cfg.Kafka, handler, log)
 	defer func() {
 		if err := consumer.Close(); err != nil {
@@ -77,5 +92,53 @@ func main() {
 		}
 	}()
 
-	shutdown.Graceful(log, cancel)
+	resultSink := outbox.NewKafkaSink(cfg.Kafka.Brokers, cfg.Kafka.TopicResults, worker.BookingKey, eventcodec.OutboxEncoder)
+	defer func() {
+		if err := resultSink.Close(); err != nil {
+			log.Error("failed to close kafka producer", "error", err)
+		}
+	}()
+
+	sinks := outbox.Router{
+		eventcodec.EventTypePaymentResult: resultSink,
+		eventcodec.EventTypeRefundResult:  resultSink,
+
+		eventcodec.EventTypePaymentCaptureResult: resultSink,
+		eventcodec.EventTypePaymentVoidResult:    resultSink,
+	}
+	relayCfg := outbox.Config{
+		BatchSize: cfg.Outbox.BatchSize,
+		Interval:  cfg.Outbox.Interval,
+		Retry: outbox.RetryPolicy{
+			MaxAttempts: cfg.Outbox.MaxAttempts,
+			BaseBackoff: cfg.Outbox.BaseBackoff,
+			MaxBackoff:  cfg.Outbox.MaxBackoff,
+		},
+	}
+	outboxRelay := outbox.NewRelay(database, pgrepo.OutboxTable, sinks, relayCfg, log)
+	recoveryWorker := worker.NewRecoveryWorker(paymentService, log, cfg.Recovery.Interval, cfg.Recovery.BatchSize)
+	// Every worker runs on one replica only, elected per worker.
+	go leader.New(database, "payment-outbox-relay", cfg.Leader.Interval, log).Run(ctx, outboxRelay.Start)
+	go leader.New(database, "payment-recovery", cfg.Leader.Interval, log).Run(ctx, recoveryWorker.Start)
+
+	grpcServerImpl := paymentgrpc.NewServer(paymentService, cfg.GRPC.Timeout)
+	grpcServer := grpc.NewServer()
+	grpcServerImpl.Register(grpcServer)
+	reflection.Register(grpcServer)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
+	if err != nil {
+		log.Error("failed to listen", "port", cfg.GRPC.Port, "error", err)
+		os.Exit(1)
+	}
+
+	go func() {
+		log.Info("grpc server started", slog.Int("port", cfg.GRPC.Port))
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Error("failed to serve", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	shutdown.Graceful(log, cancel, grpcServer)
 }
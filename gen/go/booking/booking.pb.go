@@ -22,37 +22,35 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type Booking struct {
+type BookingItem struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId            int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FlightId          int64                  `protobuf:"varint,3,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
-	SeatNumber        string                 `protobuf:"bytes,4,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	PassengerName     string                 `protobuf:"bytes,5,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
-	PassengerPassport string                 `protobuf:"bytes,6,opt,name=passenger_passport,json=passengerPassport,proto3" json:"passenger_passport,omitempty"`
-	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	PriceCents        int64                  `protobuf:"varint,8,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
-	Currency          string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	SeatNumber        string                 `protobuf:"bytes,1,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	PassengerName     string                 `protobuf:"bytes,2,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+	PassengerPassport string                 `protobuf:"bytes,3,opt,name=passenger_passport,json=passengerPassport,proto3" json:"passenger_passport,omitempty"`
+	PriceCents        int64                  `protobuf:"varint,4,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	PassengerId       int64                  `protobuf:"varint,5,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	DocumentType      string                 `protobuf:"bytes,6,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Citizenship       string                 `protobuf:"bytes,7,opt,name=citizenship,proto3" json:"citizenship,omitempty"`
+	// 13-digit e-ticket number, empty until the booking is paid.
+	TicketNumber  string `protobuf:"bytes,8,opt,name=ticket_number,json=ticketNumber,proto3" json:"ticket_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Booking) Reset() {
-	*x = Booking{}
+func (x *BookingItem) Reset() {
+	*x = BookingItem{}
 	mi := &file_booking_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Booking) String() string {
+func (x *BookingItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Booking) ProtoMessage() {}
+func (*BookingItem) ProtoMessage() {}
 
-func (x *Booking) ProtoReflect() protoreflect.Message {
+func (x *BookingItem) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -64,53 +62,260 @@ func (x *Booking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
-func (*Booking) Descriptor() ([]byte, []int) {
+// Deprecated: Use BookingItem.ProtoReflect.Descriptor instead.
+func (*BookingItem) Descriptor() ([]byte, []int) {
 	return file_booking_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Booking) GetId() string {
+func (x *BookingItem) GetSeatNumber() string {
 	if x != nil {
-		return x.Id
+		return x.SeatNumber
 	}
 	return ""
 }
 
-func (x *Booking) GetUserId() int64 {
+func (x *BookingItem) GetPassengerName() string {
 	if x != nil {
-		return x.UserId
+		return x.PassengerName
+	}
+	return ""
+}
+
+func (x *BookingItem) GetPassengerPassport() string {
+	if x != nil {
+		return x.PassengerPassport
+	}
+	return ""
+}
+
+func (x *BookingItem) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
 	}
 	return 0
 }
 
-func (x *Booking) GetFlightId() int64 {
+func (x *BookingItem) GetPassengerId() int64 {
 	if x != nil {
-		return x.FlightId
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *BookingItem) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *BookingItem) GetCitizenship() string {
+	if x != nil {
+		return x.Citizenship
+	}
+	return ""
+}
+
+func (x *BookingItem) GetTicketNumber() string {
+	if x != nil {
+		return x.TicketNumber
+	}
+	return ""
+}
+
+// Caller identifies the authenticated user a request is made on behalf of.
+type Caller struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Caller) Reset() {
+	*x = Caller{}
+	mi := &file_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Caller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Caller) ProtoMessage() {}
+
+func (x *Caller) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Caller.ProtoReflect.Descriptor instead.
+func (*Caller) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Caller) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Booking) GetSeatNumber() string {
+func (x *Caller) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// BookingPassenger references a saved passenger profile by passenger_id,
+// or carries passenger_name and passenger_passport inline when it is not set.
+type BookingPassenger struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SeatNumber        string                 `protobuf:"bytes,1,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	PassengerName     string                 `protobuf:"bytes,2,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+	PassengerPassport string                 `protobuf:"bytes,3,opt,name=passenger_passport,json=passengerPassport,proto3" json:"passenger_passport,omitempty"`
+	PassengerId       int64                  `protobuf:"varint,4,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BookingPassenger) Reset() {
+	*x = BookingPassenger{}
+	mi := &file_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPassenger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPassenger) ProtoMessage() {}
+
+func (x *BookingPassenger) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPassenger.ProtoReflect.Descriptor instead.
+func (*BookingPassenger) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookingPassenger) GetSeatNumber() string {
 	if x != nil {
 		return x.SeatNumber
 	}
 	return ""
 }
 
-func (x *Booking) GetPassengerName() string {
+func (x *BookingPassenger) GetPassengerName() string {
 	if x != nil {
 		return x.PassengerName
 	}
 	return ""
 }
 
-func (x *Booking) GetPassengerPassport() string {
+func (x *BookingPassenger) GetPassengerPassport() string {
 	if x != nil {
 		return x.PassengerPassport
 	}
 	return ""
 }
 
+func (x *BookingPassenger) GetPassengerId() int64 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+type Booking struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FlightId   int64                  `protobuf:"varint,3,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	Status     string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	PriceCents int64                  `protobuf:"varint,8,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Currency   string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Items      []*BookingItem         `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`
+	// Six character record locator shown to the customer.
+	Pnr string `protobuf:"bytes,13,opt,name=pnr,proto3" json:"pnr,omitempty"`
+	// Nil for bookings made before departure times were recorded.
+	DepartureTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Booking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Booking) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Booking) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
 func (x *Booking) GetStatus() string {
 	if x != nil {
 		return x.Status
@@ -146,22 +351,44 @@ func (x *Booking) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Booking) GetItems() []*BookingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Booking) GetPnr() string {
+	if x != nil {
+		return x.Pnr
+	}
+	return ""
+}
+
+func (x *Booking) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
 type CreateBookingRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FlightId          int64                  `protobuf:"varint,2,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
-	SeatNumber        string                 `protobuf:"bytes,3,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	PassengerName     string                 `protobuf:"bytes,4,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
-	PassengerPassport string                 `protobuf:"bytes,5,opt,name=passenger_passport,json=passengerPassport,proto3" json:"passenger_passport,omitempty"`
-	PriceCents        int64                  `protobuf:"varint,6,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
-	Currency          string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FlightId int64                  `protobuf:"varint,2,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	Items    []*BookingPassenger    `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	// Total from GetBookingQuote that the client agreed to pay.
+	QuotedPriceCents int64 `protobuf:"varint,9,opt,name=quoted_price_cents,json=quotedPriceCents,proto3" json:"quoted_price_cents,omitempty"`
+	// Optional client generated key. A repeat with the same key returns the
+	// booking created by the first request.
+	IdempotencyKey string `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateBookingRequest) Reset() {
 	*x = CreateBookingRequest{}
-	mi := &file_booking_proto_msgTypes[1]
+	mi := &file_booking_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +400,7 @@ func (x *CreateBookingRequest) String() string {
 func (*CreateBookingRequest) ProtoMessage() {}
 
 func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[1]
+	mi := &file_booking_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +413,7 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
 func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{1}
+	return file_booking_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateBookingRequest) GetUserId() int64 {
@@ -203,37 +430,23 @@ func (x *CreateBookingRequest) GetFlightId() int64 {
 	return 0
 }
 
-func (x *CreateBookingRequest) GetSeatNumber() string {
-	if x != nil {
-		return x.SeatNumber
-	}
-	return ""
-}
-
-func (x *CreateBookingRequest) GetPassengerName() string {
-	if x != nil {
-		return x.PassengerName
-	}
-	return ""
-}
-
-func (x *CreateBookingRequest) GetPassengerPassport() string {
+func (x *CreateBookingRequest) GetItems() []*BookingPassenger {
 	if x != nil {
-		return x.PassengerPassport
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateBookingRequest) GetPriceCents() int64 {
+func (x *CreateBookingRequest) GetQuotedPriceCents() int64 {
 	if x != nil {
-		return x.PriceCents
+		return x.QuotedPriceCents
 	}
 	return 0
 }
 
-func (x *CreateBookingRequest) GetCurrency() string {
+func (x *CreateBookingRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Currency
+		return x.IdempotencyKey
 	}
 	return ""
 }
@@ -247,7 +460,7 @@ type CreateBookingResponse struct {
 
 func (x *CreateBookingResponse) Reset() {
 	*x = CreateBookingResponse{}
-	mi := &file_booking_proto_msgTypes[2]
+	mi := &file_booking_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -259,7 +472,7 @@ func (x *CreateBookingResponse) String() string {
 func (*CreateBookingResponse) ProtoMessage() {}
 
 func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[2]
+	mi := &file_booking_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +485,7 @@ func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
 func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{2}
+	return file_booking_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateBookingResponse) GetBookingId() string {
@@ -285,13 +498,14 @@ func (x *CreateBookingResponse) GetBookingId() string {
 type GetBookingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBookingRequest) Reset() {
 	*x = GetBookingRequest{}
-	mi := &file_booking_proto_msgTypes[3]
+	mi := &file_booking_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +517,7 @@ func (x *GetBookingRequest) String() string {
 func (*GetBookingRequest) ProtoMessage() {}
 
 func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[3]
+	mi := &file_booking_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +530,7 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
 func (*GetBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{3}
+	return file_booking_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetBookingRequest) GetBookingId() string {
@@ -326,6 +540,13 @@ func (x *GetBookingRequest) GetBookingId() string {
 	return ""
 }
 
+func (x *GetBookingRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
 type GetBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
@@ -335,7 +556,7 @@ type GetBookingResponse struct {
 
 func (x *GetBookingResponse) Reset() {
 	*x = GetBookingResponse{}
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +568,7 @@ func (x *GetBookingResponse) String() string {
 func (*GetBookingResponse) ProtoMessage() {}
 
 func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +581,7 @@ func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
 func (*GetBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{4}
+	return file_booking_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetBookingResponse) GetBooking() *Booking {
@@ -371,15 +592,25 @@ func (x *GetBookingResponse) GetBooking() *Booking {
 }
 
 type ListBookingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Defaults to 20, capped at 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_cursor of the previous page, empty for the first page.
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Statuses      []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	FlightId      int64                  `protobuf:"varint,5,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	DepartureFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=departure_from,json=departureFrom,proto3" json:"departure_from,omitempty"`
+	DepartureTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=departure_to,json=departureTo,proto3" json:"departure_to,omitempty"`
+	// "desc" (newest first, default) or "asc".
+	SortOrder     string `protobuf:"bytes,8,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListBookingsRequest) Reset() {
 	*x = ListBookingsRequest{}
-	mi := &file_booking_proto_msgTypes[5]
+	mi := &file_booking_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +622,7 @@ func (x *ListBookingsRequest) String() string {
 func (*ListBookingsRequest) ProtoMessage() {}
 
 func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[5]
+	mi := &file_booking_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +635,7 @@ func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
 func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{5}
+	return file_booking_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListBookingsRequest) GetUserId() int64 {
@@ -414,28 +645,1622 @@ func (x *ListBookingsRequest) GetUserId() int64 {
 	return 0
 }
 
-type ListBookingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListBookingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *ListBookingsResponse) Reset() {
+func (x *ListBookingsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *ListBookingsRequest) GetDepartureFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureFrom
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetDepartureTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTo
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+type ListBookingsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Bookings []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	// Empty when there are no more pages.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsResponse) Reset() {
 	*x = ListBookingsResponse{}
-	mi := &file_booking_proto_msgTypes[6]
+	mi := &file_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *ListBookingsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{11}
+}
+
+// LookupBookingRequest is used by unauthenticated travellers, the passenger
+// surname has to match one of the passengers on the booking.
+type LookupBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pnr           string                 `protobuf:"bytes,1,opt,name=pnr,proto3" json:"pnr,omitempty"`
+	LastName      string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupBookingRequest) Reset() {
+	*x = LookupBookingRequest{}
+	mi := &file_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupBookingRequest) ProtoMessage() {}
+
+func (x *LookupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupBookingRequest.ProtoReflect.Descriptor instead.
+func (*LookupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LookupBookingRequest) GetPnr() string {
+	if x != nil {
+		return x.Pnr
+	}
+	return ""
+}
+
+func (x *LookupBookingRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type LookupBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupBookingResponse) Reset() {
+	*x = LookupBookingResponse{}
+	mi := &file_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupBookingResponse) ProtoMessage() {}
+
+func (x *LookupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupBookingResponse.ProtoReflect.Descriptor instead.
+func (*LookupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LookupBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type ChangeSeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	OldSeatNumber string                 `protobuf:"bytes,3,opt,name=old_seat_number,json=oldSeatNumber,proto3" json:"old_seat_number,omitempty"`
+	NewSeatNumber string                 `protobuf:"bytes,4,opt,name=new_seat_number,json=newSeatNumber,proto3" json:"new_seat_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeSeatRequest) Reset() {
+	*x = ChangeSeatRequest{}
+	mi := &file_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatRequest) ProtoMessage() {}
+
+func (x *ChangeSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSeatRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChangeSeatRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *ChangeSeatRequest) GetOldSeatNumber() string {
+	if x != nil {
+		return x.OldSeatNumber
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetNewSeatNumber() string {
+	if x != nil {
+		return x.NewSeatNumber
+	}
+	return ""
+}
+
+type ChangeSeatResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Booking *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	// Positive when the new seat is more expensive and an extra payment is
+	// charged, negative when the difference is refunded. A pending booking is
+	// charged the extra payment once it is paid, and has only its lower price
+	// captured instead of a refund.
+	PriceDifferenceCents int64 `protobuf:"varint,2,opt,name=price_difference_cents,json=priceDifferenceCents,proto3" json:"price_difference_cents,omitempty"`
+	// APPLIED, or AWAITING_PAYMENT while the surcharge is charged; the booking
+	// keeps its old seat until then.
+	SeatChangeStatus string `protobuf:"bytes,3,opt,name=seat_change_status,json=seatChangeStatus,proto3" json:"seat_change_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChangeSeatResponse) Reset() {
+	*x = ChangeSeatResponse{}
+	mi := &file_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatResponse) ProtoMessage() {}
+
+func (x *ChangeSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSeatResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChangeSeatResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *ChangeSeatResponse) GetPriceDifferenceCents() int64 {
+	if x != nil {
+		return x.PriceDifferenceCents
+	}
+	return 0
+}
+
+func (x *ChangeSeatResponse) GetSeatChangeStatus() string {
+	if x != nil {
+		return x.SeatChangeStatus
+	}
+	return ""
+}
+
+type GetBookingQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlightId      int64                  `protobuf:"varint,1,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	SeatNumbers   []string               `protobuf:"bytes,2,rep,name=seat_numbers,json=seatNumbers,proto3" json:"seat_numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingQuoteRequest) Reset() {
+	*x = GetBookingQuoteRequest{}
+	mi := &file_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingQuoteRequest) ProtoMessage() {}
+
+func (x *GetBookingQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBookingQuoteRequest) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *GetBookingQuoteRequest) GetSeatNumbers() []string {
+	if x != nil {
+		return x.SeatNumbers
+	}
+	return nil
+}
+
+type QuoteItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatNumber    string                 `protobuf:"bytes,1,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	PriceCents    int64                  `protobuf:"varint,2,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteItem) Reset() {
+	*x = QuoteItem{}
+	mi := &file_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteItem) ProtoMessage() {}
+
+func (x *QuoteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteItem.ProtoReflect.Descriptor instead.
+func (*QuoteItem) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QuoteItem) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+type GetBookingQuoteResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FlightId        int64                  `protobuf:"varint,1,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	Items           []*QuoteItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPriceCents int64                  `protobuf:"varint,3,opt,name=total_price_cents,json=totalPriceCents,proto3" json:"total_price_cents,omitempty"`
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBookingQuoteResponse) Reset() {
+	*x = GetBookingQuoteResponse{}
+	mi := &file_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingQuoteResponse) ProtoMessage() {}
+
+func (x *GetBookingQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBookingQuoteResponse) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *GetBookingQuoteResponse) GetItems() []*QuoteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetBookingQuoteResponse) GetTotalPriceCents() int64 {
+	if x != nil {
+		return x.TotalPriceCents
+	}
+	return 0
+}
+
+func (x *GetBookingQuoteResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type BookingStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    string                 `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingStatusChange) Reset() {
+	*x = BookingStatusChange{}
+	mi := &file_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChange) ProtoMessage() {}
+
+func (x *BookingStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChange.ProtoReflect.Descriptor instead.
+func (*BookingStatusChange) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BookingStatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	mi := &file_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBookingHistoryRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *GetBookingHistoryRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+type GetBookingHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*BookingStatusChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryResponse) Reset() {
+	*x = GetBookingHistoryResponse{}
+	mi := &file_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryResponse) ProtoMessage() {}
+
+func (x *GetBookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetBookingHistoryResponse) GetHistory() []*BookingStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type BoardingPass struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeatNumber     string                 `protobuf:"bytes,1,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	PassengerName  string                 `protobuf:"bytes,2,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+	SequenceNumber int32                  `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// IATA bar coded boarding pass data, encoded as PDF417 on the printed pass.
+	Bcbp          string `protobuf:"bytes,4,opt,name=bcbp,proto3" json:"bcbp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardingPass) Reset() {
+	*x = BoardingPass{}
+	mi := &file_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardingPass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardingPass) ProtoMessage() {}
+
+func (x *BoardingPass) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardingPass.ProtoReflect.Descriptor instead.
+func (*BoardingPass) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BoardingPass) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *BoardingPass) GetPassengerName() string {
+	if x != nil {
+		return x.PassengerName
+	}
+	return ""
+}
+
+func (x *BoardingPass) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *BoardingPass) GetBcbp() string {
+	if x != nil {
+		return x.Bcbp
+	}
+	return ""
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_booking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckInRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+type CheckInResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BoardingPasses []*BoardingPass        `protobuf:"bytes,1,rep,name=boarding_passes,json=boardingPasses,proto3" json:"boarding_passes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckInResponse) Reset() {
+	*x = CheckInResponse{}
+	mi := &file_booking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResponse) ProtoMessage() {}
+
+func (x *CheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResponse.ProtoReflect.Descriptor instead.
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckInResponse) GetBoardingPasses() []*BoardingPass {
+	if x != nil {
+		return x.BoardingPasses
+	}
+	return nil
+}
+
+type GetBoardingPassRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller    *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// "bcbp" (default), "png" or "pdf".
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Limits the result to one passenger, required for png on multi-passenger bookings.
+	SeatNumber    string `protobuf:"bytes,4,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardingPassRequest) Reset() {
+	*x = GetBoardingPassRequest{}
+	mi := &file_booking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardingPassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardingPassRequest) ProtoMessage() {}
+
+func (x *GetBoardingPassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardingPassRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardingPassRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetBoardingPassRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *GetBoardingPassRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *GetBoardingPassRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetBoardingPassRequest) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+type GetBoardingPassResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BoardingPasses []*BoardingPass        `protobuf:"bytes,1,rep,name=boarding_passes,json=boardingPasses,proto3" json:"boarding_passes,omitempty"`
+	// Rendered pass for the png and pdf formats.
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardingPassResponse) Reset() {
+	*x = GetBoardingPassResponse{}
+	mi := &file_booking_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardingPassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardingPassResponse) ProtoMessage() {}
+
+func (x *GetBoardingPassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardingPassResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardingPassResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetBoardingPassResponse) GetBoardingPasses() []*BoardingPass {
+	if x != nil {
+		return x.BoardingPasses
+	}
+	return nil
+}
+
+func (x *GetBoardingPassResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetBoardingPassResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetItineraryReceiptRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller    *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// "html" (default) or "pdf".
+	Format        string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItineraryReceiptRequest) Reset() {
+	*x = GetItineraryReceiptRequest{}
+	mi := &file_booking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItineraryReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItineraryReceiptRequest) ProtoMessage() {}
+
+func (x *GetItineraryReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItineraryReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetItineraryReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetItineraryReceiptRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *GetItineraryReceiptRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *GetItineraryReceiptRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetItineraryReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItineraryReceiptResponse) Reset() {
+	*x = GetItineraryReceiptResponse{}
+	mi := &file_booking_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItineraryReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItineraryReceiptResponse) ProtoMessage() {}
+
+func (x *GetItineraryReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItineraryReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetItineraryReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetItineraryReceiptResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetItineraryReceiptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// RebookingOffer is made when the booking's flight is cancelled and a later
+// flight on the same route has room for all of its passengers.
+type RebookingOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	FlightId      int64                  `protobuf:"varint,2,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	DepartureTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	// "OFFERED" or "ACCEPTED".
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Set once the offer is accepted.
+	NewBookingId  string `protobuf:"bytes,5,opt,name=new_booking_id,json=newBookingId,proto3" json:"new_booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebookingOffer) Reset() {
+	*x = RebookingOffer{}
+	mi := &file_booking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebookingOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebookingOffer) ProtoMessage() {}
+
+func (x *RebookingOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebookingOffer.ProtoReflect.Descriptor instead.
+func (*RebookingOffer) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RebookingOffer) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *RebookingOffer) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *RebookingOffer) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *RebookingOffer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RebookingOffer) GetNewBookingId() string {
+	if x != nil {
+		return x.NewBookingId
+	}
+	return ""
+}
+
+type GetRebookingOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebookingOfferRequest) Reset() {
+	*x = GetRebookingOfferRequest{}
+	mi := &file_booking_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebookingOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebookingOfferRequest) ProtoMessage() {}
+
+func (x *GetRebookingOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebookingOfferRequest.ProtoReflect.Descriptor instead.
+func (*GetRebookingOfferRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetRebookingOfferRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *GetRebookingOfferRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+type GetRebookingOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *RebookingOffer        `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebookingOfferResponse) Reset() {
+	*x = GetRebookingOfferResponse{}
+	mi := &file_booking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebookingOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebookingOfferResponse) ProtoMessage() {}
+
+func (x *GetRebookingOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebookingOfferResponse.ProtoReflect.Descriptor instead.
+func (*GetRebookingOfferResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetRebookingOfferResponse) GetOffer() *RebookingOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type AcceptRebookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptRebookingRequest) Reset() {
+	*x = AcceptRebookingRequest{}
+	mi := &file_booking_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptRebookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptRebookingRequest) ProtoMessage() {}
+
+func (x *AcceptRebookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptRebookingRequest.ProtoReflect.Descriptor instead.
+func (*AcceptRebookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AcceptRebookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *AcceptRebookingRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+type AcceptRebookingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new pending booking on the offered flight.
+	BookingId     string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptRebookingResponse) Reset() {
+	*x = AcceptRebookingResponse{}
+	mi := &file_booking_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptRebookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptRebookingResponse) ProtoMessage() {}
+
+func (x *AcceptRebookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptRebookingResponse.ProtoReflect.Descriptor instead.
+func (*AcceptRebookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AcceptRebookingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type OutboxEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// JSON payload as stored in the outbox.
+	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts      int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboxEvent) Reset() {
+	*x = OutboxEvent{}
+	mi := &file_booking_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEvent) ProtoMessage() {}
+
+func (x *OutboxEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEvent.ProtoReflect.Descriptor instead.
+func (*OutboxEvent) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OutboxEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OutboxEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListDeadOutboxEventsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Caller *Caller                `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Defaults to 50, at most 500.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadOutboxEventsRequest) Reset() {
+	*x = ListDeadOutboxEventsRequest{}
+	mi := &file_booking_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadOutboxEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadOutboxEventsRequest) ProtoMessage() {}
+
+func (x *ListDeadOutboxEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadOutboxEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadOutboxEventsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListDeadOutboxEventsRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *ListDeadOutboxEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeadOutboxEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*OutboxEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadOutboxEventsResponse) Reset() {
+	*x = ListDeadOutboxEventsResponse{}
+	mi := &file_booking_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBookingsResponse) String() string {
+func (x *ListDeadOutboxEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBookingsResponse) ProtoMessage() {}
+func (*ListDeadOutboxEventsResponse) ProtoMessage() {}
 
-func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[6]
+func (x *ListDeadOutboxEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,40 +2271,41 @@ func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
-func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListDeadOutboxEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadOutboxEventsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListBookingsResponse) GetBookings() []*Booking {
+func (x *ListDeadOutboxEventsResponse) GetEvents() []*OutboxEvent {
 	if x != nil {
-		return x.Bookings
+		return x.Events
 	}
 	return nil
 }
 
-type CancelBookingRequest struct {
+type ReplayOutboxEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Caller        *Caller                `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingRequest) Reset() {
-	*x = CancelBookingRequest{}
-	mi := &file_booking_proto_msgTypes[7]
+func (x *ReplayOutboxEventRequest) Reset() {
+	*x = ReplayOutboxEventRequest{}
+	mi := &file_booking_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingRequest) String() string {
+func (x *ReplayOutboxEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingRequest) ProtoMessage() {}
+func (*ReplayOutboxEventRequest) ProtoMessage() {}
 
-func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[7]
+func (x *ReplayOutboxEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,39 +2316,289 @@ func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
-func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReplayOutboxEventRequest.ProtoReflect.Descriptor instead.
+func (*ReplayOutboxEventRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CancelBookingRequest) GetBookingId() string {
+func (x *ReplayOutboxEventRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *ReplayOutboxEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ReplayOutboxEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayOutboxEventResponse) Reset() {
+	*x = ReplayOutboxEventResponse{}
+	mi := &file_booking_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayOutboxEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOutboxEventResponse) ProtoMessage() {}
+
+func (x *ReplayOutboxEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOutboxEventResponse.ProtoReflect.Descriptor instead.
+func (*ReplayOutboxEventResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{38}
+}
+
+type Saga struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Empty when the saga was aborted before the booking was stored.
+	BookingId string `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId    int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FlightId  int64  `protobuf:"varint,4,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	// RESERVING_SEATS, AWAITING_PAYMENT, CONFIRMING_SEATS, COMPLETED,
+	// COMPENSATING or COMPENSATED.
+	State         string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	ReservedSeats []string `protobuf:"bytes,6,rep,name=reserved_seats,json=reservedSeats,proto3" json:"reserved_seats,omitempty"`
+	FailureReason string   `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Failed attempts of the current step.
+	Attempts      int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Saga) Reset() {
+	*x = Saga{}
+	mi := &file_booking_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Saga) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Saga) ProtoMessage() {}
+
+func (x *Saga) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Saga.ProtoReflect.Descriptor instead.
+func (*Saga) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Saga) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Saga) GetBookingId() string {
 	if x != nil {
 		return x.BookingId
 	}
 	return ""
 }
 
-type CancelBookingResponse struct {
+func (x *Saga) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Saga) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *Saga) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Saga) GetReservedSeats() []string {
+	if x != nil {
+		return x.ReservedSeats
+	}
+	return nil
+}
+
+func (x *Saga) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Saga) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Saga) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Saga) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *Saga) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Saga) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSagasRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Caller *Caller                `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Optional filters.
+	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	BookingId string `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Defaults to 50, at most 500.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSagasRequest) Reset() {
+	*x = ListSagasRequest{}
+	mi := &file_booking_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSagasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSagasRequest) ProtoMessage() {}
+
+func (x *ListSagasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSagasRequest.ProtoReflect.Descriptor instead.
+func (*ListSagasRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSagasRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *ListSagasRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListSagasRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ListSagasRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSagasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sagas         []*Saga                `protobuf:"bytes,1,rep,name=sagas,proto3" json:"sagas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingResponse) Reset() {
-	*x = CancelBookingResponse{}
-	mi := &file_booking_proto_msgTypes[8]
+func (x *ListSagasResponse) Reset() {
+	*x = ListSagasResponse{}
+	mi := &file_booking_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingResponse) String() string {
+func (x *ListSagasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingResponse) ProtoMessage() {}
+func (*ListSagasResponse) ProtoMessage() {}
 
-func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[8]
+func (x *ListSagasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,24 +2609,47 @@ func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
-func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListSagasResponse.ProtoReflect.Descriptor instead.
+func (*ListSagasResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSagasResponse) GetSagas() []*Saga {
+	if x != nil {
+		return x.Sagas
+	}
+	return nil
 }
 
 var File_booking_proto protoreflect.FileDescriptor
 
 const file_booking_proto_rawDesc = "" +
 	"\n" +
-	"\rbooking.proto\x12\abooking\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x03\n" +
+	"\rbooking.proto\x12\abooking\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x02\n" +
+	"\vBookingItem\x12\x1f\n" +
+	"\vseat_number\x18\x01 \x01(\tR\n" +
+	"seatNumber\x12%\n" +
+	"\x0epassenger_name\x18\x02 \x01(\tR\rpassengerName\x12-\n" +
+	"\x12passenger_passport\x18\x03 \x01(\tR\x11passengerPassport\x12\x1f\n" +
+	"\vprice_cents\x18\x04 \x01(\x03R\n" +
+	"priceCents\x12!\n" +
+	"\fpassenger_id\x18\x05 \x01(\x03R\vpassengerId\x12#\n" +
+	"\rdocument_type\x18\x06 \x01(\tR\fdocumentType\x12 \n" +
+	"\vcitizenship\x18\a \x01(\tR\vcitizenship\x12#\n" +
+	"\rticket_number\x18\b \x01(\tR\fticketNumber\"5\n" +
+	"\x06Caller\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\xac\x01\n" +
+	"\x10BookingPassenger\x12\x1f\n" +
+	"\vseat_number\x18\x01 \x01(\tR\n" +
+	"seatNumber\x12%\n" +
+	"\x0epassenger_name\x18\x02 \x01(\tR\rpassengerName\x12-\n" +
+	"\x12passenger_passport\x18\x03 \x01(\tR\x11passengerPassport\x12!\n" +
+	"\fpassenger_id\x18\x04 \x01(\x03R\vpassengerId\"\xde\x03\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tflight_id\x18\x03 \x01(\x03R\bflightId\x12\x1f\n" +
-	"\vseat_number\x18\x04 \x01(\tR\n" +
-	"seatNumber\x12%\n" +
-	"\x0epassenger_name\x18\x05 \x01(\tR\rpassengerName\x12-\n" +
-	"\x12passenger_passport\x18\x06 \x01(\tR\x11passengerPassport\x12\x16\n" +
+	"\tflight_id\x18\x03 \x01(\x03R\bflightId\x12\x16\n" +
 	"\x06status\x18\a \x01(\tR\x06status\x12\x1f\n" +
 	"\vprice_cents\x18\b \x01(\x03R\n" +
 	"priceCents\x12\x1a\n" +
@@ -559,39 +2658,204 @@ const file_booking_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x80\x02\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12*\n" +
+	"\x05items\x18\f \x03(\v2\x14.booking.BookingItemR\x05items\x12\x10\n" +
+	"\x03pnr\x18\r \x01(\tR\x03pnr\x12A\n" +
+	"\x0edeparture_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTimeJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\vseat_numberR\x0epassenger_nameR\x12passenger_passport\"\xba\x02\n" +
 	"\x14CreateBookingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tflight_id\x18\x02 \x01(\x03R\bflightId\x12\x1f\n" +
-	"\vseat_number\x18\x03 \x01(\tR\n" +
-	"seatNumber\x12%\n" +
-	"\x0epassenger_name\x18\x04 \x01(\tR\rpassengerName\x12-\n" +
-	"\x12passenger_passport\x18\x05 \x01(\tR\x11passengerPassport\x12\x1f\n" +
-	"\vprice_cents\x18\x06 \x01(\x03R\n" +
-	"priceCents\x12\x1a\n" +
-	"\bcurrency\x18\a \x01(\tR\bcurrency\"6\n" +
+	"\tflight_id\x18\x02 \x01(\x03R\bflightId\x12/\n" +
+	"\x05items\x18\b \x03(\v2\x19.booking.BookingPassengerR\x05items\x12,\n" +
+	"\x12quoted_price_cents\x18\t \x01(\x03R\x10quotedPriceCents\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKeyJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\aJ\x04\b\a\x10\bR\vseat_numberR\x0epassenger_nameR\x12passenger_passportR\vprice_centsR\bcurrency\"6\n" +
 	"\x15CreateBookingResponse\x12\x1d\n" +
 	"\n" +
-	"booking_id\x18\x01 \x01(\tR\tbookingId\"2\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"[\n" +
 	"\x11GetBookingRequest\x12\x1d\n" +
 	"\n" +
-	"booking_id\x18\x01 \x01(\tR\tbookingId\"@\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\"@\n" +
 	"\x12GetBookingResponse\x12*\n" +
-	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\".\n" +
+	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\"\xbd\x02\n" +
 	"\x13ListBookingsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"D\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\x12\x1b\n" +
+	"\tflight_id\x18\x05 \x01(\x03R\bflightId\x12A\n" +
+	"\x0edeparture_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureFrom\x12=\n" +
+	"\fdeparture_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vdepartureTo\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\b \x01(\tR\tsortOrder\"e\n" +
 	"\x14ListBookingsResponse\x12,\n" +
-	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\"5\n" +
+	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"^\n" +
 	"\x14CancelBookingRequest\x12\x1d\n" +
 	"\n" +
-	"booking_id\x18\x01 \x01(\tR\tbookingId\"\x17\n" +
-	"\x15CancelBookingResponse2\xc4\x02\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\"\x17\n" +
+	"\x15CancelBookingResponse\"E\n" +
+	"\x14LookupBookingRequest\x12\x10\n" +
+	"\x03pnr\x18\x01 \x01(\tR\x03pnr\x12\x1b\n" +
+	"\tlast_name\x18\x02 \x01(\tR\blastName\"C\n" +
+	"\x15LookupBookingResponse\x12*\n" +
+	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\"\xab\x01\n" +
+	"\x11ChangeSeatRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\x12&\n" +
+	"\x0fold_seat_number\x18\x03 \x01(\tR\roldSeatNumber\x12&\n" +
+	"\x0fnew_seat_number\x18\x04 \x01(\tR\rnewSeatNumber\"\xa4\x01\n" +
+	"\x12ChangeSeatResponse\x12*\n" +
+	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\x124\n" +
+	"\x16price_difference_cents\x18\x02 \x01(\x03R\x14priceDifferenceCents\x12,\n" +
+	"\x12seat_change_status\x18\x03 \x01(\tR\x10seatChangeStatus\"X\n" +
+	"\x16GetBookingQuoteRequest\x12\x1b\n" +
+	"\tflight_id\x18\x01 \x01(\x03R\bflightId\x12!\n" +
+	"\fseat_numbers\x18\x02 \x03(\tR\vseatNumbers\"M\n" +
+	"\tQuoteItem\x12\x1f\n" +
+	"\vseat_number\x18\x01 \x01(\tR\n" +
+	"seatNumber\x12\x1f\n" +
+	"\vprice_cents\x18\x02 \x01(\x03R\n" +
+	"priceCents\"\xa8\x01\n" +
+	"\x17GetBookingQuoteResponse\x12\x1b\n" +
+	"\tflight_id\x18\x01 \x01(\x03R\bflightId\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.booking.QuoteItemR\x05items\x12*\n" +
+	"\x11total_price_cents\x18\x03 \x01(\x03R\x0ftotalPriceCents\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xd7\x01\n" +
+	"\x13BookingStatusChange\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"b\n" +
+	"\x18GetBookingHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\"S\n" +
+	"\x19GetBookingHistoryResponse\x126\n" +
+	"\ahistory\x18\x01 \x03(\v2\x1c.booking.BookingStatusChangeR\ahistory\"\x93\x01\n" +
+	"\fBoardingPass\x12\x1f\n" +
+	"\vseat_number\x18\x01 \x01(\tR\n" +
+	"seatNumber\x12%\n" +
+	"\x0epassenger_name\x18\x02 \x01(\tR\rpassengerName\x12'\n" +
+	"\x0fsequence_number\x18\x03 \x01(\x05R\x0esequenceNumber\x12\x12\n" +
+	"\x04bcbp\x18\x04 \x01(\tR\x04bcbp\"X\n" +
+	"\x0eCheckInRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\"Q\n" +
+	"\x0fCheckInResponse\x12>\n" +
+	"\x0fboarding_passes\x18\x01 \x03(\v2\x15.booking.BoardingPassR\x0eboardingPasses\"\x99\x01\n" +
+	"\x16GetBoardingPassRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1f\n" +
+	"\vseat_number\x18\x04 \x01(\tR\n" +
+	"seatNumber\"\x96\x01\n" +
+	"\x17GetBoardingPassResponse\x12>\n" +
+	"\x0fboarding_passes\x18\x01 \x03(\v2\x15.booking.BoardingPassR\x0eboardingPasses\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"|\n" +
+	"\x1aGetItineraryReceiptRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"Z\n" +
+	"\x1bGetItineraryReceiptResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\xcd\x01\n" +
+	"\x0eRebookingOffer\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x1b\n" +
+	"\tflight_id\x18\x02 \x01(\x03R\bflightId\x12A\n" +
+	"\x0edeparture_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTime\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12$\n" +
+	"\x0enew_booking_id\x18\x05 \x01(\tR\fnewBookingId\"b\n" +
+	"\x18GetRebookingOfferRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\"J\n" +
+	"\x19GetRebookingOfferResponse\x12-\n" +
+	"\x05offer\x18\x01 \x01(\v2\x17.booking.RebookingOfferR\x05offer\"`\n" +
+	"\x16AcceptRebookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x06caller\x18\x02 \x01(\v2\x0f.booking.CallerR\x06caller\"8\n" +
+	"\x17AcceptRebookingResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"\xcc\x01\n" +
+	"\vOutboxEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\tR\apayload\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
+	"\x1bListDeadOutboxEventsRequest\x12'\n" +
+	"\x06caller\x18\x01 \x01(\v2\x0f.booking.CallerR\x06caller\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"L\n" +
+	"\x1cListDeadOutboxEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.booking.OutboxEventR\x06events\"^\n" +
+	"\x18ReplayOutboxEventRequest\x12'\n" +
+	"\x06caller\x18\x01 \x01(\v2\x0f.booking.CallerR\x06caller\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"\x1b\n" +
+	"\x19ReplayOutboxEventResponse\"\xc4\x03\n" +
+	"\x04Saga\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tflight_id\x18\x04 \x01(\x03R\bflightId\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12%\n" +
+	"\x0ereserved_seats\x18\x06 \x03(\tR\rreservedSeats\x12%\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x86\x01\n" +
+	"\x10ListSagasRequest\x12'\n" +
+	"\x06caller\x18\x01 \x01(\v2\x0f.booking.CallerR\x06caller\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\tR\tbookingId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"8\n" +
+	"\x11ListSagasResponse\x12#\n" +
+	"\x05sagas\x18\x01 \x03(\v2\r.booking.SagaR\x05sagas2\xba\n" +
+	"\n" +
 	"\x0eBookingService\x12N\n" +
 	"\rCreateBooking\x12\x1d.booking.CreateBookingRequest\x1a\x1e.booking.CreateBookingResponse\x12E\n" +
 	"\n" +
 	"GetBooking\x12\x1a.booking.GetBookingRequest\x1a\x1b.booking.GetBookingResponse\x12K\n" +
 	"\fListBookings\x12\x1c.booking.ListBookingsRequest\x1a\x1d.booking.ListBookingsResponse\x12N\n" +
-	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponseB2Z0github.com/squ1ky/flyte/gen/go/booking;bookingv1b\x06proto3"
+	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponse\x12T\n" +
+	"\x0fGetBookingQuote\x12\x1f.booking.GetBookingQuoteRequest\x1a .booking.GetBookingQuoteResponse\x12Z\n" +
+	"\x11GetBookingHistory\x12!.booking.GetBookingHistoryRequest\x1a\".booking.GetBookingHistoryResponse\x12E\n" +
+	"\n" +
+	"ChangeSeat\x12\x1a.booking.ChangeSeatRequest\x1a\x1b.booking.ChangeSeatResponse\x12N\n" +
+	"\rLookupBooking\x12\x1d.booking.LookupBookingRequest\x1a\x1e.booking.LookupBookingResponse\x12<\n" +
+	"\aCheckIn\x12\x17.booking.CheckInRequest\x1a\x18.booking.CheckInResponse\x12T\n" +
+	"\x0fGetBoardingPass\x12\x1f.booking.GetBoardingPassRequest\x1a .booking.GetBoardingPassResponse\x12`\n" +
+	"\x13GetItineraryReceipt\x12#.booking.GetItineraryReceiptRequest\x1a$.booking.GetItineraryReceiptResponse\x12Z\n" +
+	"\x11GetRebookingOffer\x12!.booking.GetRebookingOfferRequest\x1a\".booking.GetRebookingOfferResponse\x12T\n" +
+	"\x0fAcceptRebooking\x12\x1f.booking.AcceptRebookingRequest\x1a .booking.AcceptRebookingResponse\x12c\n" +
+	"\x14ListDeadOutboxEvents\x12$.booking.ListDeadOutboxEventsRequest\x1a%.booking.ListDeadOutboxEventsResponse\x12Z\n" +
+	"\x11ReplayOutboxEvent\x12!.booking.ReplayOutboxEventRequest\x1a\".booking.ReplayOutboxEventResponse\x12B\n" +
+	"\tListSagas\x12\x19.booking.ListSagasRequest\x1a\x1a.booking.ListSagasResponseB2Z0github.com/squ1ky/flyte/gen/go/booking;bookingv1b\x06proto3"
 
 var (
 	file_booking_proto_rawDescOnce sync.Once
@@ -605,37 +2869,126 @@ func file_booking_proto_rawDescGZIP() []byte {
 	return file_booking_proto_rawDescData
 }
 
-var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_booking_proto_goTypes = []any{
-	(*Booking)(nil),               // 0: booking.Booking
-	(*CreateBookingRequest)(nil),  // 1: booking.CreateBookingRequest
-	(*CreateBookingResponse)(nil), // 2: booking.CreateBookingResponse
-	(*GetBookingRequest)(nil),     // 3: booking.GetBookingRequest
-	(*GetBookingResponse)(nil),    // 4: booking.GetBookingResponse
-	(*ListBookingsRequest)(nil),   // 5: booking.ListBookingsRequest
-	(*ListBookingsResponse)(nil),  // 6: booking.ListBookingsResponse
-	(*CancelBookingRequest)(nil),  // 7: booking.CancelBookingRequest
-	(*CancelBookingResponse)(nil), // 8: booking.CancelBookingResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*BookingItem)(nil),                  // 0: booking.BookingItem
+	(*Caller)(nil),                       // 1: booking.Caller
+	(*BookingPassenger)(nil),             // 2: booking.BookingPassenger
+	(*Booking)(nil),                      // 3: booking.Booking
+	(*CreateBookingRequest)(nil),         // 4: booking.CreateBookingRequest
+	(*CreateBookingResponse)(nil),        // 5: booking.CreateBookingResponse
+	(*GetBookingRequest)(nil),            // 6: booking.GetBookingRequest
+	(*GetBookingResponse)(nil),           // 7: booking.GetBookingResponse
+	(*ListBookingsRequest)(nil),          // 8: booking.ListBookingsRequest
+	(*ListBookingsResponse)(nil),         // 9: booking.ListBookingsResponse
+	(*CancelBookingRequest)(nil),         // 10: booking.CancelBookingRequest
+	(*CancelBookingResponse)(nil),        // 11: booking.CancelBookingResponse
+	(*LookupBookingRequest)(nil),         // 12: booking.LookupBookingRequest
+	(*LookupBookingResponse)(nil),        // 13: booking.LookupBookingResponse
+	(*ChangeSeatRequest)(nil),            // 14: booking.ChangeSeatRequest
+	(*ChangeSeatResponse)(nil),           // 15: booking.ChangeSeatResponse
+	(*GetBookingQuoteRequest)(nil),       // 16: booking.GetBookingQuoteRequest
+	(*QuoteItem)(nil),                    // 17: booking.QuoteItem
+	(*GetBookingQuoteResponse)(nil),      // 18: booking.GetBookingQuoteResponse
+	(*BookingStatusChange)(nil),          // 19: booking.BookingStatusChange
+	(*GetBookingHistoryRequest)(nil),     // 20: booking.GetBookingHistoryRequest
+	(*GetBookingHistoryResponse)(nil),    // 21: booking.GetBookingHistoryResponse
+	(*BoardingPass)(nil),                 // 22: booking.BoardingPass
+	(*CheckInRequest)(nil),               // 23: booking.CheckInRequest
+	(*CheckInResponse)(nil),              // 24: booking.CheckInResponse
+	(*GetBoardingPassRequest)(nil),       // 25: booking.GetBoardingPassRequest
+	(*GetBoardingPassResponse)(nil),      // 26: booking.GetBoardingPassResponse
+	(*GetItineraryReceiptRequest)(nil),   // 27: booking.GetItineraryReceiptRequest
+	(*GetItineraryReceiptResponse)(nil),  // 28: booking.GetItineraryReceiptResponse
+	(*RebookingOffer)(nil),               // 29: booking.RebookingOffer
+	(*GetRebookingOfferRequest)(nil),     // 30: booking.GetRebookingOfferRequest
+	(*GetRebookingOfferResponse)(nil),    // 31: booking.GetRebookingOfferResponse
+	(*AcceptRebookingRequest)(nil),       // 32: booking.AcceptRebookingRequest
+	(*AcceptRebookingResponse)(nil),      // 33: booking.AcceptRebookingResponse
+	(*OutboxEvent)(nil),                  // 34: booking.OutboxEvent
+	(*ListDeadOutboxEventsRequest)(nil),  // 35: booking.ListDeadOutboxEventsRequest
+	(*ListDeadOutboxEventsResponse)(nil), // 36: booking.ListDeadOutboxEventsResponse
+	(*ReplayOutboxEventRequest)(nil),     // 37: booking.ReplayOutboxEventRequest
+	(*ReplayOutboxEventResponse)(nil),    // 38: booking.ReplayOutboxEventResponse
+	(*Saga)(nil),                         // 39: booking.Saga
+	(*ListSagasRequest)(nil),             // 40: booking.ListSagasRequest
+	(*ListSagasResponse)(nil),            // 41: booking.ListSagasResponse
+	(*timestamppb.Timestamp)(nil),        // 42: google.protobuf.Timestamp
 }
 var file_booking_proto_depIdxs = []int32{
-	9, // 0: booking.Booking.created_at:type_name -> google.protobuf.Timestamp
-	9, // 1: booking.Booking.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: booking.GetBookingResponse.booking:type_name -> booking.Booking
-	0, // 3: booking.ListBookingsResponse.bookings:type_name -> booking.Booking
-	1, // 4: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
-	3, // 5: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
-	5, // 6: booking.BookingService.ListBookings:input_type -> booking.ListBookingsRequest
-	7, // 7: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
-	2, // 8: booking.BookingService.CreateBooking:output_type -> booking.CreateBookingResponse
-	4, // 9: booking.BookingService.GetBooking:output_type -> booking.GetBookingResponse
-	6, // 10: booking.BookingService.ListBookings:output_type -> booking.ListBookingsResponse
-	8, // 11: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	42, // 0: booking.Booking.created_at:type_name -> google.protobuf.Timestamp
+	42, // 1: booking.Booking.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: booking.Booking.items:type_name -> booking.BookingItem
+	42, // 3: booking.Booking.departure_time:type_name -> google.protobuf.Timestamp
+	2,  // 4: booking.CreateBookingRequest.items:type_name -> booking.BookingPassenger
+	1,  // 5: booking.GetBookingRequest.caller:type_name -> booking.Caller
+	3,  // 6: booking.GetBookingResponse.booking:type_name -> booking.Booking
+	42, // 7: booking.ListBookingsRequest.departure_from:type_name -> google.protobuf.Timestamp
+	42, // 8: booking.ListBookingsRequest.departure_to:type_name -> google.protobuf.Timestamp
+	3,  // 9: booking.ListBookingsResponse.bookings:type_name -> booking.Booking
+	1,  // 10: booking.CancelBookingRequest.caller:type_name -> booking.Caller
+	3,  // 11: booking.LookupBookingResponse.booking:type_name -> booking.Booking
+	1,  // 12: booking.ChangeSeatRequest.caller:type_name -> booking.Caller
+	3,  // 13: booking.ChangeSeatResponse.booking:type_name -> booking.Booking
+	17, // 14: booking.GetBookingQuoteResponse.items:type_name -> booking.QuoteItem
+	42, // 15: booking.BookingStatusChange.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 16: booking.GetBookingHistoryRequest.caller:type_name -> booking.Caller
+	19, // 17: booking.GetBookingHistoryResponse.history:type_name -> booking.BookingStatusChange
+	1,  // 18: booking.CheckInRequest.caller:type_name -> booking.Caller
+	22, // 19: booking.CheckInResponse.boarding_passes:type_name -> booking.BoardingPass
+	1,  // 20: booking.GetBoardingPassRequest.caller:type_name -> booking.Caller
+	22, // 21: booking.GetBoardingPassResponse.boarding_passes:type_name -> booking.BoardingPass
+	1,  // 22: booking.GetItineraryReceiptRequest.caller:type_name -> booking.Caller
+	42, // 23: booking.RebookingOffer.departure_time:type_name -> google.protobuf.Timestamp
+	1,  // 24: booking.GetRebookingOfferRequest.caller:type_name -> booking.Caller
+	29, // 25: booking.GetRebookingOfferResponse.offer:type_name -> booking.RebookingOffer
+	1,  // 26: booking.AcceptRebookingRequest.caller:type_name -> booking.Caller
+	42, // 27: booking.OutboxEvent.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 28: booking.ListDeadOutboxEventsRequest.caller:type_name -> booking.Caller
+	34, // 29: booking.ListDeadOutboxEventsResponse.events:type_name -> booking.OutboxEvent
+	1,  // 30: booking.ReplayOutboxEventRequest.caller:type_name -> booking.Caller
+	42, // 31: booking.Saga.next_attempt_at:type_name -> google.protobuf.Timestamp
+	42, // 32: booking.Saga.created_at:type_name -> google.protobuf.Timestamp
+	42, // 33: booking.Saga.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 34: booking.ListSagasRequest.caller:type_name -> booking.Caller
+	39, // 35: booking.ListSagasResponse.sagas:type_name -> booking.Saga
+	4,  // 36: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
+	6,  // 37: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
+	8,  // 38: booking.BookingService.ListBookings:input_type -> booking.ListBookingsRequest
+	10, // 39: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
+	16, // 40: booking.BookingService.GetBookingQuote:input_type -> booking.GetBookingQuoteRequest
+	20, // 41: booking.BookingService.GetBookingHistory:input_type -> booking.GetBookingHistoryRequest
+	14, // 42: booking.BookingService.ChangeSeat:input_type -> booking.ChangeSeatRequest
+	12, // 43: booking.BookingService.LookupBooking:input_type -> booking.LookupBookingRequest
+	23, // 44: booking.BookingService.CheckIn:input_type -> booking.CheckInRequest
+	25, // 45: booking.BookingService.GetBoardingPass:input_type -> booking.GetBoardingPassRequest
+	27, // 46: booking.BookingService.GetItineraryReceipt:input_type -> booking.GetItineraryReceiptRequest
+	30, // 47: booking.BookingService.GetRebookingOffer:input_type -> booking.GetRebookingOfferRequest
+	32, // 48: booking.BookingService.AcceptRebooking:input_type -> booking.AcceptRebookingRequest
+	35, // 49: booking.BookingService.ListDeadOutboxEvents:input_type -> booking.ListDeadOutboxEventsRequest
+	37, // 50: booking.BookingService.ReplayOutboxEvent:input_type -> booking.ReplayOutboxEventRequest
+	40, // 51: booking.BookingService.ListSagas:input_type -> booking.ListSagasRequest
+	5,  // 52: booking.BookingService.CreateBooking:output_type -> booking.CreateBookingResponse
+	7,  // 53: booking.BookingService.GetBooking:output_type -> booking.GetBookingResponse
+	9,  // 54: booking.BookingService.ListBookings:output_type -> booking.ListBookingsResponse
+	11, // 55: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
+	18, // 56: booking.BookingService.GetBookingQuote:output_type -> booking.GetBookingQuoteResponse
+	21, // 57: booking.BookingService.GetBookingHistory:output_type -> booking.GetBookingHistoryResponse
+	15, // 58: booking.BookingService.ChangeSeat:output_type -> booking.ChangeSeatResponse
+	13, // 59: booking.BookingService.LookupBooking:output_type -> booking.LookupBookingResponse
+	24, // 60: booking.BookingService.CheckIn:output_type -> booking.CheckInResponse
+	26, // 61: booking.BookingService.GetBoardingPass:output_type -> booking.GetBoardingPassResponse
+	28, // 62: booking.BookingService.GetItineraryReceipt:output_type -> booking.GetItineraryReceiptResponse
+	31, // 63: booking.BookingService.GetRebookingOffer:output_type -> booking.GetRebookingOfferResponse
+	33, // 64: booking.BookingService.AcceptRebooking:output_type -> booking.AcceptRebookingResponse
+	36, // 65: booking.BookingService.ListDeadOutboxEvents:output_type -> booking.ListDeadOutboxEventsResponse
+	38, // 66: booking.BookingService.ReplayOutboxEvent:output_type -> booking.ReplayOutboxEventResponse
+	41, // 67: booking.BookingService.ListSagas:output_type -> booking.ListSagasResponse
+	52, // [52:68] is the sub-list for method output_type
+	36, // [36:52] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_booking_proto_init() }
@@ -649,7 +3002,7 @@ func file_booking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_proto_rawDesc), len(file_booking_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
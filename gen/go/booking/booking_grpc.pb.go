@@ -19,10 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName = "/booking.BookingService/CreateBooking"
-	BookingService_GetBooking_FullMethodName    = "/booking.BookingService/GetBooking"
-	BookingService_ListBookings_FullMethodName  = "/booking.BookingService/ListBookings"
-	BookingService_CancelBooking_FullMethodName = "/booking.BookingService/CancelBooking"
+	BookingService_CreateBooking_FullMethodName        = "/booking.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName           = "/booking.BookingService/GetBooking"
+	BookingService_ListBookings_FullMethodName         = "/booking.BookingService/ListBookings"
+	BookingService_CancelBooking_FullMethodName        = "/booking.BookingService/CancelBooking"
+	BookingService_GetBookingQuote_FullMethodName      = "/booking.BookingService/GetBookingQuote"
+	BookingService_GetBookingHistory_FullMethodName    = "/booking.BookingService/GetBookingHistory"
+	BookingService_ChangeSeat_FullMethodName           = "/booking.BookingService/ChangeSeat"
+	BookingService_LookupBooking_FullMethodName        = "/booking.BookingService/LookupBooking"
+	BookingService_CheckIn_FullMethodName              = "/booking.BookingService/CheckIn"
+	BookingService_GetBoardingPass_FullMethodName      = "/booking.BookingService/GetBoardingPass"
+	BookingService_GetItineraryReceipt_FullMethodName  = "/booking.BookingService/GetItineraryReceipt"
+	BookingService_GetRebookingOffer_FullMethodName    = "/booking.BookingService/GetRebookingOffer"
+	BookingService_AcceptRebooking_FullMethodName      = "/booking.BookingService/AcceptRebooking"
+	BookingService_ListDeadOutboxEvents_FullMethodName = "/booking.BookingService/ListDeadOutboxEvents"
+	BookingService_ReplayOutboxEvent_FullMethodName    = "/booking.BookingService/ReplayOutboxEvent"
+	BookingService_ListSagas_FullMethodName            = "/booking.BookingService/ListSagas"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -33,6 +45,20 @@ type BookingServiceClient interface {
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
 	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	GetBookingQuote(ctx context.Context, in *GetBookingQuoteRequest, opts ...grpc.CallOption) (*GetBookingQuoteResponse, error)
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error)
+	ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*ChangeSeatResponse, error)
+	LookupBooking(ctx context.Context, in *LookupBookingRequest, opts ...grpc.CallOption) (*LookupBookingResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	GetBoardingPass(ctx context.Context, in *GetBoardingPassRequest, opts ...grpc.CallOption) (*GetBoardingPassResponse, error)
+	GetItineraryReceipt(ctx context.Context, in *GetItineraryReceiptRequest, opts ...grpc.CallOption) (*GetItineraryReceiptResponse, error)
+	GetRebookingOffer(ctx context.Context, in *GetRebookingOfferRequest, opts ...grpc.CallOption) (*GetRebookingOfferResponse, error)
+	AcceptRebooking(ctx context.Context, in *AcceptRebookingRequest, opts ...grpc.CallOption) (*AcceptRebookingResponse, error)
+	// Admin only: inspect and replay outbox events that could not be published.
+	ListDeadOutboxEvents(ctx context.Context, in *ListDeadOutboxEventsRequest, opts ...grpc.CallOption) (*ListDeadOutboxEventsResponse, error)
+	ReplayOutboxEvent(ctx context.Context, in *ReplayOutboxEventRequest, opts ...grpc.CallOption) (*ReplayOutboxEventResponse, error)
+	// Admin only: inspect the sagas placing bookings across services.
+	ListSagas(ctx context.Context, in *ListSagasRequest, opts ...grpc.CallOption) (*ListSagasResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -83,6 +109,126 @@ func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) GetBookingQuote(ctx context.Context, in *GetBookingQuoteRequest, opts ...grpc.CallOption) (*GetBookingQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingQuoteResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingHistoryResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*ChangeSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeSeatResponse)
+	err := c.cc.Invoke(ctx, BookingService_ChangeSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) LookupBooking(ctx context.Context, in *LookupBookingRequest, opts ...grpc.CallOption) (*LookupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_LookupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, BookingService_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBoardingPass(ctx context.Context, in *GetBoardingPassRequest, opts ...grpc.CallOption) (*GetBoardingPassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBoardingPassResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBoardingPass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetItineraryReceipt(ctx context.Context, in *GetItineraryReceiptRequest, opts ...grpc.CallOption) (*GetItineraryReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItineraryReceiptResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetItineraryReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetRebookingOffer(ctx context.Context, in *GetRebookingOfferRequest, opts ...grpc.CallOption) (*GetRebookingOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRebookingOfferResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetRebookingOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AcceptRebooking(ctx context.Context, in *AcceptRebookingRequest, opts ...grpc.CallOption) (*AcceptRebookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptRebookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_AcceptRebooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListDeadOutboxEvents(ctx context.Context, in *ListDeadOutboxEventsRequest, opts ...grpc.CallOption) (*ListDeadOutboxEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadOutboxEventsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListDeadOutboxEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ReplayOutboxEvent(ctx context.Context, in *ReplayOutboxEventRequest, opts ...grpc.CallOption) (*ReplayOutboxEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayOutboxEventResponse)
+	err := c.cc.Invoke(ctx, BookingService_ReplayOutboxEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListSagas(ctx context.Context, in *ListSagasRequest, opts ...grpc.CallOption) (*ListSagasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSagasResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListSagas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
@@ -91,6 +237,20 @@ type BookingServiceServer interface {
 	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
 	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
 	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	GetBookingQuote(context.Context, *GetBookingQuoteRequest) (*GetBookingQuoteResponse, error)
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error)
+	ChangeSeat(context.Context, *ChangeSeatRequest) (*ChangeSeatResponse, error)
+	LookupBooking(context.Context, *LookupBookingRequest) (*LookupBookingResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	GetBoardingPass(context.Context, *GetBoardingPassRequest) (*GetBoardingPassResponse, error)
+	GetItineraryReceipt(context.Context, *GetItineraryReceiptRequest) (*GetItineraryReceiptResponse, error)
+	GetRebookingOffer(context.Context, *GetRebookingOfferRequest) (*GetRebookingOfferResponse, error)
+	AcceptRebooking(context.Context, *AcceptRebookingRequest) (*AcceptRebookingResponse, error)
+	// Admin only: inspect and replay outbox events that could not be published.
+	ListDeadOutboxEvents(context.Context, *ListDeadOutboxEventsRequest) (*ListDeadOutboxEventsResponse, error)
+	ReplayOutboxEvent(context.Context, *ReplayOutboxEventRequest) (*ReplayOutboxEventResponse, error)
+	// Admin only: inspect the sagas placing bookings across services.
+	ListSagas(context.Context, *ListSagasRequest) (*ListSagasResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -113,6 +273,42 @@ func (UnimplementedBookingServiceServer) ListBookings(context.Context, *ListBook
 func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) GetBookingQuote(context.Context, *GetBookingQuoteRequest) (*GetBookingQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingQuote not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
+func (UnimplementedBookingServiceServer) ChangeSeat(context.Context, *ChangeSeatRequest) (*ChangeSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSeat not implemented")
+}
+func (UnimplementedBookingServiceServer) LookupBooking(context.Context, *LookupBookingRequest) (*LookupBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBoardingPass(context.Context, *GetBoardingPassRequest) (*GetBoardingPassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardingPass not implemented")
+}
+func (UnimplementedBookingServiceServer) GetItineraryReceipt(context.Context, *GetItineraryReceiptRequest) (*GetItineraryReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItineraryReceipt not implemented")
+}
+func (UnimplementedBookingServiceServer) GetRebookingOffer(context.Context, *GetRebookingOfferRequest) (*GetRebookingOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebookingOffer not implemented")
+}
+func (UnimplementedBookingServiceServer) AcceptRebooking(context.Context, *AcceptRebookingRequest) (*AcceptRebookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRebooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListDeadOutboxEvents(context.Context, *ListDeadOutboxEventsRequest) (*ListDeadOutboxEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadOutboxEvents not implemented")
+}
+func (UnimplementedBookingServiceServer) ReplayOutboxEvent(context.Context, *ReplayOutboxEventRequest) (*ReplayOutboxEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOutboxEvent not implemented")
+}
+func (UnimplementedBookingServiceServer) ListSagas(context.Context, *ListSagasRequest) (*ListSagasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSagas not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +402,222 @@ func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_GetBookingQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingQuote(ctx, req.(*GetBookingQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ChangeSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ChangeSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ChangeSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ChangeSeat(ctx, req.(*ChangeSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_LookupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).LookupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_LookupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).LookupBooking(ctx, req.(*LookupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBoardingPass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardingPassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBoardingPass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBoardingPass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBoardingPass(ctx, req.(*GetBoardingPassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetItineraryReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItineraryReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetItineraryReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetItineraryReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetItineraryReceipt(ctx, req.(*GetItineraryReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetRebookingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebookingOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetRebookingOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetRebookingOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetRebookingOffer(ctx, req.(*GetRebookingOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AcceptRebooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptRebookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AcceptRebooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AcceptRebooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AcceptRebooking(ctx, req.(*AcceptRebookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListDeadOutboxEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadOutboxEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListDeadOutboxEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListDeadOutboxEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListDeadOutboxEvents(ctx, req.(*ListDeadOutboxEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ReplayOutboxEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOutboxEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ReplayOutboxEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ReplayOutboxEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ReplayOutboxEvent(ctx, req.(*ReplayOutboxEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListSagas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSagasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListSagas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListSagas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListSagas(ctx, req.(*ListSagasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +641,54 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBooking",
 			Handler:    _BookingService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "GetBookingQuote",
+			Handler:    _BookingService_GetBookingQuote_Handler,
+		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _BookingService_GetBookingHistory_Handler,
+		},
+		{
+			MethodName: "ChangeSeat",
+			Handler:    _BookingService_ChangeSeat_Handler,
+		},
+		{
+			MethodName: "LookupBooking",
+			Handler:    _BookingService_LookupBooking_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _BookingService_CheckIn_Handler,
+		},
+		{
+			MethodName: "GetBoardingPass",
+			Handler:    _BookingService_GetBoardingPass_Handler,
+		},
+		{
+			MethodName: "GetItineraryReceipt",
+			Handler:    _BookingService_GetItineraryReceipt_Handler,
+		},
+		{
+			MethodName: "GetRebookingOffer",
+			Handler:    _BookingService_GetRebookingOffer_Handler,
+		},
+		{
+			MethodName: "AcceptRebooking",
+			Handler:    _BookingService_AcceptRebooking_Handler,
+		},
+		{
+			MethodName: "ListDeadOutboxEvents",
+			Handler:    _BookingService_ListDeadOutboxEvents_Handler,
+		},
+		{
+			MethodName: "ReplayOutboxEvent",
+			Handler:    _BookingService_ReplayOutboxEvent_Handler,
+		},
+		{
+			MethodName: "ListSagas",
+			Handler:    _BookingService_ListSagas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking.proto",
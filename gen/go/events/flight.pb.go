@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: flight.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FlightCancelled is published when a flight is cancelled, event type
+// FLIGHT_CANCELLED on the flight events topic.
+type FlightCancelled struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FlightId         int64                  `protobuf:"varint,1,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	FlightNumber     string                 `protobuf:"bytes,2,opt,name=flight_number,json=flightNumber,proto3" json:"flight_number,omitempty"`
+	DepartureAirport string                 `protobuf:"bytes,3,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	ArrivalAirport   string                 `protobuf:"bytes,4,opt,name=arrival_airport,json=arrivalAirport,proto3" json:"arrival_airport,omitempty"`
+	DepartureTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	Reason           string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CancelledAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FlightCancelled) Reset() {
+	*x = FlightCancelled{}
+	mi := &file_flight_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlightCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightCancelled) ProtoMessage() {}
+
+func (x *FlightCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_flight_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightCancelled.ProtoReflect.Descriptor instead.
+func (*FlightCancelled) Descriptor() ([]byte, []int) {
+	return file_flight_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FlightCancelled) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *FlightCancelled) GetFlightNumber() string {
+	if x != nil {
+		return x.FlightNumber
+	}
+	return ""
+}
+
+func (x *FlightCancelled) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *FlightCancelled) GetArrivalAirport() string {
+	if x != nil {
+		return x.ArrivalAirport
+	}
+	return ""
+}
+
+func (x *FlightCancelled) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *FlightCancelled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FlightCancelled) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+var File_flight_proto protoreflect.FileDescriptor
+
+const file_flight_proto_rawDesc = "" +
+	"\n" +
+	"\fflight.proto\x12\x06events\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x02\n" +
+	"\x0fFlightCancelled\x12\x1b\n" +
+	"\tflight_id\x18\x01 \x01(\x03R\bflightId\x12#\n" +
+	"\rflight_number\x18\x02 \x01(\tR\fflightNumber\x12+\n" +
+	"\x11departure_airport\x18\x03 \x01(\tR\x10departureAirport\x12'\n" +
+	"\x0farrival_airport\x18\x04 \x01(\tR\x0earrivalAirport\x12A\n" +
+	"\x0edeparture_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTime\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12=\n" +
+	"\fcancelled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAtB0Z.github.com/squ1ky/flyte/gen/go/events;eventsv1b\x06proto3"
+
+var (
+	file_flight_proto_rawDescOnce sync.Once
+	file_flight_proto_rawDescData []byte
+)
+
+func file_flight_proto_rawDescGZIP() []byte {
+	file_flight_proto_rawDescOnce.Do(func() {
+		file_flight_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_flight_proto_rawDesc), len(file_flight_proto_rawDesc)))
+	})
+	return file_flight_proto_rawDescData
+}
+
+var file_flight_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_flight_proto_goTypes = []any{
+	(*FlightCancelled)(nil),       // 0: events.FlightCancelled
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_flight_proto_depIdxs = []int32{
+	1, // 0: events.FlightCancelled.departure_time:type_name -> google.protobuf.Timestamp
+	1, // 1: events.FlightCancelled.cancelled_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_flight_proto_init() }
+func file_flight_proto_init() {
+	if File_flight_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_flight_proto_rawDesc), len(file_flight_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_flight_proto_goTypes,
+		DependencyIndexes: file_flight_proto_depIdxs,
+		MessageInfos:      file_flight_proto_msgTypes,
+	}.Build()
+	File_flight_proto = out.File
+	file_flight_proto_goTypes = nil
+	file_flight_proto_depIdxs = nil
+}
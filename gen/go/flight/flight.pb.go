@@ -102,8 +102,10 @@ type Flight struct {
 	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	TotalSeats       int32                  `protobuf:"varint,9,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
 	AvailableSeats   int32                  `protobuf:"varint,10,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// IANA time zone of the departure airport, e.g. Europe/Moscow.
+	DepartureTimezone string `protobuf:"bytes,11,opt,name=departure_timezone,json=departureTimezone,proto3" json:"departure_timezone,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Flight) Reset() {
@@ -206,6 +208,13 @@ func (x *Flight) GetAvailableSeats() int32 {
 	return 0
 }
 
+func (x *Flight) GetDepartureTimezone() string {
+	if x != nil {
+		return x.DepartureTimezone
+	}
+	return ""
+}
+
 type Seat struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -818,6 +827,224 @@ func (x *GetFlightSeatsResponse) GetSeats() []*Seat {
 	return nil
 }
 
+type CancelFlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlightId      int64                  `protobuf:"varint,1,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFlightRequest) Reset() {
+	*x = CancelFlightRequest{}
+	mi := &file_flight_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlightRequest) ProtoMessage() {}
+
+func (x *CancelFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flight_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlightRequest.ProtoReflect.Descriptor instead.
+func (*CancelFlightRequest) Descriptor() ([]byte, []int) {
+	return file_flight_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelFlightRequest) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *CancelFlightRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelFlightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flight        *Flight                `protobuf:"bytes,1,opt,name=flight,proto3" json:"flight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFlightResponse) Reset() {
+	*x = CancelFlightResponse{}
+	mi := &file_flight_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlightResponse) ProtoMessage() {}
+
+func (x *CancelFlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flight_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlightResponse.ProtoReflect.Descriptor instead.
+func (*CancelFlightResponse) Descriptor() ([]byte, []int) {
+	return file_flight_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelFlightResponse) GetFlight() *Flight {
+	if x != nil {
+		return x.Flight
+	}
+	return nil
+}
+
+// FindNextFlightRequest looks for the earliest scheduled flight on the route
+// departing after departure_after with at least passenger_count free seats.
+type FindNextFlightRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromAirport     string                 `protobuf:"bytes,1,opt,name=from_airport,json=fromAirport,proto3" json:"from_airport,omitempty"`
+	ToAirport       string                 `protobuf:"bytes,2,opt,name=to_airport,json=toAirport,proto3" json:"to_airport,omitempty"`
+	DepartureAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure_after,json=departureAfter,proto3" json:"departure_after,omitempty"`
+	PassengerCount  int32                  `protobuf:"varint,4,opt,name=passenger_count,json=passengerCount,proto3" json:"passenger_count,omitempty"`
+	ExcludeFlightId int64                  `protobuf:"varint,5,opt,name=exclude_flight_id,json=excludeFlightId,proto3" json:"exclude_flight_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindNextFlightRequest) Reset() {
+	*x = FindNextFlightRequest{}
+	mi := &file_flight_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextFlightRequest) ProtoMessage() {}
+
+func (x *FindNextFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flight_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextFlightRequest.ProtoReflect.Descriptor instead.
+func (*FindNextFlightRequest) Descriptor() ([]byte, []int) {
+	return file_flight_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FindNextFlightRequest) GetFromAirport() string {
+	if x != nil {
+		return x.FromAirport
+	}
+	return ""
+}
+
+func (x *FindNextFlightRequest) GetToAirport() string {
+	if x != nil {
+		return x.ToAirport
+	}
+	return ""
+}
+
+func (x *FindNextFlightRequest) GetDepartureAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureAfter
+	}
+	return nil
+}
+
+func (x *FindNextFlightRequest) GetPassengerCount() int32 {
+	if x != nil {
+		return x.PassengerCount
+	}
+	return 0
+}
+
+func (x *FindNextFlightRequest) GetExcludeFlightId() int64 {
+	if x != nil {
+		return x.ExcludeFlightId
+	}
+	return 0
+}
+
+type FindNextFlightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flight        *Flight                `protobuf:"bytes,1,opt,name=flight,proto3" json:"flight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNextFlightResponse) Reset() {
+	*x = FindNextFlightResponse{}
+	mi := &file_flight_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextFlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextFlightResponse) ProtoMessage() {}
+
+func (x *FindNextFlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flight_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextFlightResponse.ProtoReflect.Descriptor instead.
+func (*FindNextFlightResponse) Descriptor() ([]byte, []int) {
+	return file_flight_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FindNextFlightResponse) GetFlight() *Flight {
+	if x != nil {
+		return x.Flight
+	}
+	return nil
+}
+
 type ListAirportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -827,7 +1054,7 @@ type ListAirportsRequest struct {
 
 func (x *ListAirportsRequest) Reset() {
 	*x = ListAirportsRequest{}
-	mi := &file_flight_proto_msgTypes[13]
+	mi := &file_flight_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +1066,7 @@ func (x *ListAirportsRequest) String() string {
 func (*ListAirportsRequest) ProtoMessage() {}
 
 func (x *ListAirportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[13]
+	mi := &file_flight_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1079,7 @@ func (x *ListAirportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAirportsRequest.ProtoReflect.Descriptor instead.
 func (*ListAirportsRequest) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{13}
+	return file_flight_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListAirportsRequest) GetQuery() string {
@@ -871,7 +1098,7 @@ type ListAirportsResponse struct {
 
 func (x *ListAirportsResponse) Reset() {
 	*x = ListAirportsResponse{}
-	mi := &file_flight_proto_msgTypes[14]
+	mi := &file_flight_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -883,7 +1110,7 @@ func (x *ListAirportsResponse) String() string {
 func (*ListAirportsResponse) ProtoMessage() {}
 
 func (x *ListAirportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[14]
+	mi := &file_flight_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,7 +1123,7 @@ func (x *ListAirportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAirportsResponse.ProtoReflect.Descriptor instead.
 func (*ListAirportsResponse) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{14}
+	return file_flight_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListAirportsResponse) GetAirports() []*Airport {
@@ -916,7 +1143,7 @@ type ReserveSeatRequest struct {
 
 func (x *ReserveSeatRequest) Reset() {
 	*x = ReserveSeatRequest{}
-	mi := &file_flight_proto_msgTypes[15]
+	mi := &file_flight_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -928,7 +1155,7 @@ func (x *ReserveSeatRequest) String() string {
 func (*ReserveSeatRequest) ProtoMessage() {}
 
 func (x *ReserveSeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[15]
+	mi := &file_flight_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +1168,7 @@ func (x *ReserveSeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveSeatRequest.ProtoReflect.Descriptor instead.
 func (*ReserveSeatRequest) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{15}
+	return file_flight_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReserveSeatRequest) GetFlightId() int64 {
@@ -968,7 +1195,7 @@ type ReserveSeatResponse struct {
 
 func (x *ReserveSeatResponse) Reset() {
 	*x = ReserveSeatResponse{}
-	mi := &file_flight_proto_msgTypes[16]
+	mi := &file_flight_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -980,7 +1207,7 @@ func (x *ReserveSeatResponse) String() string {
 func (*ReserveSeatResponse) ProtoMessage() {}
 
 func (x *ReserveSeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[16]
+	mi := &file_flight_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,7 +1220,7 @@ func (x *ReserveSeatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveSeatResponse.ProtoReflect.Descriptor instead.
 func (*ReserveSeatResponse) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{16}
+	return file_flight_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReserveSeatResponse) GetSuccess() bool {
@@ -1020,7 +1247,7 @@ type ReleaseSeatRequest struct {
 
 func (x *ReleaseSeatRequest) Reset() {
 	*x = ReleaseSeatRequest{}
-	mi := &file_flight_proto_msgTypes[17]
+	mi := &file_flight_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1032,7 +1259,7 @@ func (x *ReleaseSeatRequest) String() string {
 func (*ReleaseSeatRequest) ProtoMessage() {}
 
 func (x *ReleaseSeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[17]
+	mi := &file_flight_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,7 +1272,7 @@ func (x *ReleaseSeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseSeatRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseSeatRequest) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{17}
+	return file_flight_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReleaseSeatRequest) GetFlightId() int64 {
@@ -1071,7 +1298,7 @@ type ReleaseSeatResponse struct {
 
 func (x *ReleaseSeatResponse) Reset() {
 	*x = ReleaseSeatResponse{}
-	mi := &file_flight_proto_msgTypes[18]
+	mi := &file_flight_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1083,7 +1310,7 @@ func (x *ReleaseSeatResponse) String() string {
 func (*ReleaseSeatResponse) ProtoMessage() {}
 
 func (x *ReleaseSeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[18]
+	mi := &file_flight_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1323,7 @@ func (x *ReleaseSeatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseSeatResponse.ProtoReflect.Descriptor instead.
 func (*ReleaseSeatResponse) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{18}
+	return file_flight_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ReleaseSeatResponse) GetSuccess() bool {
@@ -1116,7 +1343,7 @@ type ConfirmSeatRequest struct {
 
 func (x *ConfirmSeatRequest) Reset() {
 	*x = ConfirmSeatRequest{}
-	mi := &file_flight_proto_msgTypes[19]
+	mi := &file_flight_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1128,7 +1355,7 @@ func (x *ConfirmSeatRequest) String() string {
 func (*ConfirmSeatRequest) ProtoMessage() {}
 
 func (x *ConfirmSeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[19]
+	mi := &file_flight_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +1368,7 @@ func (x *ConfirmSeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmSeatRequest.ProtoReflect.Descriptor instead.
 func (*ConfirmSeatRequest) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{19}
+	return file_flight_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ConfirmSeatRequest) GetFlightId() int64 {
@@ -1167,7 +1394,7 @@ type ConfirmSeatResponse struct {
 
 func (x *ConfirmSeatResponse) Reset() {
 	*x = ConfirmSeatResponse{}
-	mi := &file_flight_proto_msgTypes[20]
+	mi := &file_flight_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1179,7 +1406,7 @@ func (x *ConfirmSeatResponse) String() string {
 func (*ConfirmSeatResponse) ProtoMessage() {}
 
 func (x *ConfirmSeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[20]
+	mi := &file_flight_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1419,7 @@ func (x *ConfirmSeatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmSeatResponse.ProtoReflect.Descriptor instead.
 func (*ConfirmSeatResponse) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{20}
+	return file_flight_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ConfirmSeatResponse) GetSuccess() bool {
@@ -1212,7 +1439,7 @@ type CreateAircraftRequest struct {
 
 func (x *CreateAircraftRequest) Reset() {
 	*x = CreateAircraftRequest{}
-	mi := &file_flight_proto_msgTypes[21]
+	mi := &file_flight_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1224,7 +1451,7 @@ func (x *CreateAircraftRequest) String() string {
 func (*CreateAircraftRequest) ProtoMessage() {}
 
 func (x *CreateAircraftRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[21]
+	mi := &file_flight_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,7 +1464,7 @@ func (x *CreateAircraftRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAircraftRequest.ProtoReflect.Descriptor instead.
 func (*CreateAircraftRequest) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{21}
+	return file_flight_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateAircraftRequest) GetModel() string {
@@ -1263,7 +1490,7 @@ type CreateAircraftResponse struct {
 
 func (x *CreateAircraftResponse) Reset() {
 	*x = CreateAircraftResponse{}
-	mi := &file_flight_proto_msgTypes[22]
+	mi := &file_flight_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1275,7 +1502,7 @@ func (x *CreateAircraftResponse) String() string {
 func (*CreateAircraftResponse) ProtoMessage() {}
 
 func (x *CreateAircraftResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[22]
+	mi := &file_flight_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1515,7 @@ func (x *CreateAircraftResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAircraftResponse.ProtoReflect.Descriptor instead.
 func (*CreateAircraftResponse) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{22}
+	return file_flight_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreateAircraftResponse) GetAircraftId() int64 {
@@ -1306,7 +1533,7 @@ type ListAircraftsRequest struct {
 
 func (x *ListAircraftsRequest) Reset() {
 	*x = ListAircraftsRequest{}
-	mi := &file_flight_proto_msgTypes[23]
+	mi := &file_flight_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1318,7 +1545,7 @@ func (x *ListAircraftsRequest) String() string {
 func (*ListAircraftsRequest) ProtoMessage() {}
 
 func (x *ListAircraftsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[23]
+	mi := &file_flight_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +1558,7 @@ func (x *ListAircraftsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAircraftsRequest.ProtoReflect.Descriptor instead.
 func (*ListAircraftsRequest) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{23}
+	return file_flight_proto_rawDescGZIP(), []int{27}
 }
 
 type ListAircraftsResponse struct {
@@ -1343,7 +1570,7 @@ type ListAircraftsResponse struct {
 
 func (x *ListAircraftsResponse) Reset() {
 	*x = ListAircraftsResponse{}
-	mi := &file_flight_proto_msgTypes[24]
+	mi := &file_flight_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1355,7 +1582,7 @@ func (x *ListAircraftsResponse) String() string {
 func (*ListAircraftsResponse) ProtoMessage() {}
 
 func (x *ListAircraftsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[24]
+	mi := &file_flight_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1368,7 +1595,7 @@ func (x *ListAircraftsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAircraftsResponse.ProtoReflect.Descriptor instead.
 func (*ListAircraftsResponse) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{24}
+	return file_flight_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ListAircraftsResponse) GetAircrafts() []*Aircraft {
@@ -1388,7 +1615,7 @@ type AddAircraftSeatsRequest struct {
 
 func (x *AddAircraftSeatsRequest) Reset() {
 	*x = AddAircraftSeatsRequest{}
-	mi := &file_flight_proto_msgTypes[25]
+	mi := &file_flight_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1400,7 +1627,7 @@ func (x *AddAircraftSeatsRequest) String() string {
 func (*AddAircraftSeatsRequest) ProtoMessage() {}
 
 func (x *AddAircraftSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[25]
+	mi := &file_flight_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,7 +1640,7 @@ func (x *AddAircraftSeatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAircraftSeatsRequest.ProtoReflect.Descriptor instead.
 func (*AddAircraftSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{25}
+	return file_flight_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AddAircraftSeatsRequest) GetAircraftId() int64 {
@@ -1439,7 +1666,7 @@ type AddAircraftSeatsResponse struct {
 
 func (x *AddAircraftSeatsResponse) Reset() {
 	*x = AddAircraftSeatsResponse{}
-	mi := &file_flight_proto_msgTypes[26]
+	mi := &file_flight_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1451,7 +1678,7 @@ func (x *AddAircraftSeatsResponse) String() string {
 func (*AddAircraftSeatsResponse) ProtoMessage() {}
 
 func (x *AddAircraftSeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flight_proto_msgTypes[26]
+	mi := &file_flight_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,7 +1691,7 @@ func (x *AddAircraftSeatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAircraftSeatsResponse.ProtoReflect.Descriptor instead.
 func (*AddAircraftSeatsResponse) Descriptor() ([]byte, []int) {
-	return file_flight_proto_rawDescGZIP(), []int{26}
+	return file_flight_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AddAircraftSeatsResponse) GetSuccess() bool {
@@ -1483,7 +1710,7 @@ const file_flight_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04city\x18\x03 \x01(\tR\x04city\x12\x18\n" +
-	"\acountry\x18\x04 \x01(\tR\acountry\"\xa1\x03\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\"\xd0\x03\n" +
 	"\x06Flight\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\rflight_number\x18\x02 \x01(\tR\fflightNumber\x12+\n" +
@@ -1496,7 +1723,8 @@ const file_flight_proto_rawDesc = "" +
 	"\vtotal_seats\x18\t \x01(\x05R\n" +
 	"totalSeats\x12'\n" +
 	"\x0favailable_seats\x18\n" +
-	" \x01(\x05R\x0eavailableSeats\"\x7f\n" +
+	" \x01(\x05R\x0eavailableSeats\x12-\n" +
+	"\x12departure_timezone\x18\v \x01(\tR\x11departureTimezone\"\x7f\n" +
 	"\x04Seat\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vseat_number\x18\x02 \x01(\tR\n" +
@@ -1540,7 +1768,21 @@ const file_flight_proto_rawDesc = "" +
 	"\x15GetFlightSeatsRequest\x12\x1b\n" +
 	"\tflight_id\x18\x01 \x01(\x03R\bflightId\"<\n" +
 	"\x16GetFlightSeatsResponse\x12\"\n" +
-	"\x05seats\x18\x01 \x03(\v2\f.flight.SeatR\x05seats\"+\n" +
+	"\x05seats\x18\x01 \x03(\v2\f.flight.SeatR\x05seats\"J\n" +
+	"\x13CancelFlightRequest\x12\x1b\n" +
+	"\tflight_id\x18\x01 \x01(\x03R\bflightId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\">\n" +
+	"\x14CancelFlightResponse\x12&\n" +
+	"\x06flight\x18\x01 \x01(\v2\x0e.flight.FlightR\x06flight\"\xf3\x01\n" +
+	"\x15FindNextFlightRequest\x12!\n" +
+	"\ffrom_airport\x18\x01 \x01(\tR\vfromAirport\x12\x1d\n" +
+	"\n" +
+	"to_airport\x18\x02 \x01(\tR\ttoAirport\x12C\n" +
+	"\x0fdeparture_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0edepartureAfter\x12'\n" +
+	"\x0fpassenger_count\x18\x04 \x01(\x05R\x0epassengerCount\x12*\n" +
+	"\x11exclude_flight_id\x18\x05 \x01(\x03R\x0fexcludeFlightId\"@\n" +
+	"\x16FindNextFlightResponse\x12&\n" +
+	"\x06flight\x18\x01 \x01(\v2\x0e.flight.FlightR\x06flight\"+\n" +
 	"\x13ListAirportsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\"C\n" +
 	"\x14ListAirportsResponse\x12+\n" +
@@ -1579,13 +1821,15 @@ const file_flight_proto_rawDesc = "" +
 	"aircraftId\x122\n" +
 	"\x05seats\x18\x02 \x03(\v2\x1c.flight.AircraftSeatTemplateR\x05seats\"4\n" +
 	"\x18AddAircraftSeatsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xe9\x06\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\x85\b\n" +
 	"\rFlightService\x12L\n" +
 	"\rSearchFlights\x12\x1c.flight.SearchFlightsRequest\x1a\x1d.flight.SearchFlightsResponse\x12I\n" +
 	"\fCreateFlight\x12\x1b.flight.CreateFlightRequest\x1a\x1c.flight.CreateFlightResponse\x12U\n" +
 	"\x10GetFlightDetails\x12\x1f.flight.GetFlightDetailsRequest\x1a .flight.GetFlightDetailsResponse\x12O\n" +
 	"\x0eGetFlightSeats\x12\x1d.flight.GetFlightSeatsRequest\x1a\x1e.flight.GetFlightSeatsResponse\x12I\n" +
-	"\fListAirports\x12\x1b.flight.ListAirportsRequest\x1a\x1c.flight.ListAirportsResponse\x12F\n" +
+	"\fListAirports\x12\x1b.flight.ListAirportsRequest\x1a\x1c.flight.ListAirportsResponse\x12I\n" +
+	"\fCancelFlight\x12\x1b.flight.CancelFlightRequest\x1a\x1c.flight.CancelFlightResponse\x12O\n" +
+	"\x0eFindNextFlight\x12\x1d.flight.FindNextFlightRequest\x1a\x1e.flight.FindNextFlightResponse\x12F\n" +
 	"\vReserveSeat\x12\x1a.flight.ReserveSeatRequest\x1a\x1b.flight.ReserveSeatResponse\x12F\n" +
 	"\vReleaseSeat\x12\x1a.flight.ReleaseSeatRequest\x1a\x1b.flight.ReleaseSeatResponse\x12F\n" +
 	"\vConfirmSeat\x12\x1a.flight.ConfirmSeatRequest\x1a\x1b.flight.ConfirmSeatResponse\x12O\n" +
@@ -1605,7 +1849,7 @@ func file_flight_proto_rawDescGZIP() []byte {
 	return file_flight_proto_rawDescData
 }
 
-var file_flight_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_flight_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_flight_proto_goTypes = []any{
 	(*Airport)(nil),                  // 0: flight.Airport
 	(*Flight)(nil),                   // 1: flight.Flight
@@ -1620,61 +1864,72 @@ var file_flight_proto_goTypes = []any{
 	(*GetFlightDetailsResponse)(nil), // 10: flight.GetFlightDetailsResponse
 	(*GetFlightSeatsRequest)(nil),    // 11: flight.GetFlightSeatsRequest
 	(*GetFlightSeatsResponse)(nil),   // 12: flight.GetFlightSeatsResponse
-	(*ListAirportsRequest)(nil),      // 13: flight.ListAirportsRequest
-	(*ListAirportsResponse)(nil),     // 14: flight.ListAirportsResponse
-	(*ReserveSeatRequest)(nil),       // 15: flight.ReserveSeatRequest
-	(*ReserveSeatResponse)(nil),      // 16: flight.ReserveSeatResponse
-	(*ReleaseSeatRequest)(nil),       // 17: flight.ReleaseSeatRequest
-	(*ReleaseSeatResponse)(nil),      // 18: flight.ReleaseSeatResponse
-	(*ConfirmSeatRequest)(nil),       // 19: flight.ConfirmSeatRequest
-	(*ConfirmSeatResponse)(nil),      // 20: flight.ConfirmSeatResponse
-	(*CreateAircraftRequest)(nil),    // 21: flight.CreateAircraftRequest
-	(*CreateAircraftResponse)(nil),   // 22: flight.CreateAircraftResponse
-	(*ListAircraftsRequest)(nil),     // 23: flight.ListAircraftsRequest
-	(*ListAircraftsResponse)(nil),    // 24: flight.ListAircraftsResponse
-	(*AddAircraftSeatsRequest)(nil),  // 25: flight.AddAircraftSeatsRequest
-	(*AddAircraftSeatsResponse)(nil), // 26: flight.AddAircraftSeatsResponse
-	(*timestamppb.Timestamp)(nil),    // 27: google.protobuf.Timestamp
+	(*CancelFlightRequest)(nil),      // 13: flight.CancelFlightRequest
+	(*CancelFlightResponse)(nil),     // 14: flight.CancelFlightResponse
+	(*FindNextFlightRequest)(nil),    // 15: flight.FindNextFlightRequest
+	(*FindNextFlightResponse)(nil),   // 16: flight.FindNextFlightResponse
+	(*ListAirportsRequest)(nil),      // 17: flight.ListAirportsRequest
+	(*ListAirportsResponse)(nil),     // 18: flight.ListAirportsResponse
+	(*ReserveSeatRequest)(nil),       // 19: flight.ReserveSeatRequest
+	(*ReserveSeatResponse)(nil),      // 20: flight.ReserveSeatResponse
+	(*ReleaseSeatRequest)(nil),       // 21: flight.ReleaseSeatRequest
+	(*ReleaseSeatResponse)(nil),      // 22: flight.ReleaseSeatResponse
+	(*ConfirmSeatRequest)(nil),       // 23: flight.ConfirmSeatRequest
+	(*ConfirmSeatResponse)(nil),      // 24: flight.ConfirmSeatResponse
+	(*CreateAircraftRequest)(nil),    // 25: flight.CreateAircraftRequest
+	(*CreateAircraftResponse)(nil),   // 26: flight.CreateAircraftResponse
+	(*ListAircraftsRequest)(nil),     // 27: flight.ListAircraftsRequest
+	(*ListAircraftsResponse)(nil),    // 28: flight.ListAircraftsResponse
+	(*AddAircraftSeatsRequest)(nil),  // 29: flight.AddAircraftSeatsRequest
+	(*AddAircraftSeatsResponse)(nil), // 30: flight.AddAircraftSeatsResponse
+	(*timestamppb.Timestamp)(nil),    // 31: google.protobuf.Timestamp
 }
 var file_flight_proto_depIdxs = []int32{
-	27, // 0: flight.Flight.departure_time:type_name -> google.protobuf.Timestamp
-	27, // 1: flight.Flight.arrival_time:type_name -> google.protobuf.Timestamp
-	27, // 2: flight.SearchFlightsRequest.date:type_name -> google.protobuf.Timestamp
+	31, // 0: flight.Flight.departure_time:type_name -> google.protobuf.Timestamp
+	31, // 1: flight.Flight.arrival_time:type_name -> google.protobuf.Timestamp
+	31, // 2: flight.SearchFlightsRequest.date:type_name -> google.protobuf.Timestamp
 	1,  // 3: flight.SearchFlightsResponse.flights:type_name -> flight.Flight
-	27, // 4: flight.CreateFlightRequest.departure_time:type_name -> google.protobuf.Timestamp
-	27, // 5: flight.CreateFlightRequest.arrival_time:type_name -> google.protobuf.Timestamp
+	31, // 4: flight.CreateFlightRequest.departure_time:type_name -> google.protobuf.Timestamp
+	31, // 5: flight.CreateFlightRequest.arrival_time:type_name -> google.protobuf.Timestamp
 	1,  // 6: flight.GetFlightDetailsResponse.flight:type_name -> flight.Flight
 	2,  // 7: flight.GetFlightSeatsResponse.seats:type_name -> flight.Seat
-	0,  // 8: flight.ListAirportsResponse.airports:type_name -> flight.Airport
-	3,  // 9: flight.ListAircraftsResponse.aircrafts:type_name -> flight.Aircraft
-	4,  // 10: flight.AddAircraftSeatsRequest.seats:type_name -> flight.AircraftSeatTemplate
-	5,  // 11: flight.FlightService.SearchFlights:input_type -> flight.SearchFlightsRequest
-	7,  // 12: flight.FlightService.CreateFlight:input_type -> flight.CreateFlightRequest
-	9,  // 13: flight.FlightService.GetFlightDetails:input_type -> flight.GetFlightDetailsRequest
-	11, // 14: flight.FlightService.GetFlightSeats:input_type -> flight.GetFlightSeatsRequest
-	13, // 15: flight.FlightService.ListAirports:input_type -> flight.ListAirportsRequest
-	15, // 16: flight.FlightService.ReserveSeat:input_type -> flight.ReserveSeatRequest
-	17, // 17: flight.FlightService.ReleaseSeat:input_type -> flight.ReleaseSeatRequest
-	19, // 18: flight.FlightService.ConfirmSeat:input_type -> flight.ConfirmSeatRequest
-	21, // 19: flight.FlightService.CreateAircraft:input_type -> flight.CreateAircraftRequest
-	23, // 20: flight.FlightService.ListAircrafts:input_type -> flight.ListAircraftsRequest
-	25, // 21: flight.FlightService.AddAircraftSeats:input_type -> flight.AddAircraftSeatsRequest
-	6,  // 22: flight.FlightService.SearchFlights:output_type -> flight.SearchFlightsResponse
-	8,  // 23: flight.FlightService.CreateFlight:output_type -> flight.CreateFlightResponse
-	10, // 24: flight.FlightService.GetFlightDetails:output_type -> flight.GetFlightDetailsResponse
-	12, // 25: flight.FlightService.GetFlightSeats:output_type -> flight.GetFlightSeatsResponse
-	14, // 26: flight.FlightService.ListAirports:output_type -> flight.ListAirportsResponse
-	16, // 27: flight.FlightService.ReserveSeat:output_type -> flight.ReserveSeatResponse
-	18, // 28: flight.FlightService.ReleaseSeat:output_type -> flight.ReleaseSeatResponse
-	20, // 29: flight.FlightService.ConfirmSeat:output_type -> flight.ConfirmSeatResponse
-	22, // 30: flight.FlightService.CreateAircraft:output_type -> flight.CreateAircraftResponse
-	24, // 31: flight.FlightService.ListAircrafts:output_type -> flight.ListAircraftsResponse
-	26, // 32: flight.FlightService.AddAircraftSeats:output_type -> flight.AddAircraftSeatsResponse
-	22, // [22:33] is the sub-list for method output_type
-	11, // [11:22] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	1,  // 8: flight.CancelFlightResponse.flight:type_name -> flight.Flight
+	31, // 9: flight.FindNextFlightRequest.departure_after:type_name -> google.protobuf.Timestamp
+	1,  // 10: flight.FindNextFlightResponse.flight:type_name -> flight.Flight
+	0,  // 11: flight.ListAirportsResponse.airports:type_name -> flight.Airport
+	3,  // 12: flight.ListAircraftsResponse.aircrafts:type_name -> flight.Aircraft
+	4,  // 13: flight.AddAircraftSeatsRequest.seats:type_name -> flight.AircraftSeatTemplate
+	5,  // 14: flight.FlightService.SearchFlights:input_type -> flight.SearchFlightsRequest
+	7,  // 15: flight.FlightService.CreateFlight:input_type -> flight.CreateFlightRequest
+	9,  // 16: flight.FlightService.GetFlightDetails:input_type -> flight.GetFlightDetailsRequest
+	11, // 17: flight.FlightService.GetFlightSeats:input_type -> flight.GetFlightSeatsRequest
+	17, // 18: flight.FlightService.ListAirports:input_type -> flight.ListAirportsRequest
+	13, // 19: flight.FlightService.CancelFlight:input_type -> flight.CancelFlightRequest
+	15, // 20: flight.FlightService.FindNextFlight:input_type -> flight.FindNextFlightRequest
+	19, // 21: flight.FlightService.ReserveSeat:input_type -> flight.ReserveSeatRequest
+	21, // 22: flight.FlightService.ReleaseSeat:input_type -> flight.ReleaseSeatRequest
+	23, // 23: flight.FlightService.ConfirmSeat:input_type -> flight.ConfirmSeatRequest
+	25, // 24: flight.FlightService.CreateAircraft:input_type -> flight.CreateAircraftRequest
+	27, // 25: flight.FlightService.ListAircrafts:input_type -> flight.ListAircraftsRequest
+	29, // 26: flight.FlightService.AddAircraftSeats:input_type -> flight.AddAircraftSeatsRequest
+	6,  // 27: flight.FlightService.SearchFlights:output_type -> flight.SearchFlightsResponse
+	8,  // 28: flight.FlightService.CreateFlight:output_type -> flight.CreateFlightResponse
+	10, // 29: flight.FlightService.GetFlightDetails:output_type -> flight.GetFlightDetailsResponse
+	12, // 30: flight.FlightService.GetFlightSeats:output_type -> flight.GetFlightSeatsResponse
+	18, // 31: flight.FlightService.ListAirports:output_type -> flight.ListAirportsResponse
+	14, // 32: flight.FlightService.CancelFlight:output_type -> flight.CancelFlightResponse
+	16, // 33: flight.FlightService.FindNextFlight:output_type -> flight.FindNextFlightResponse
+	20, // 34: flight.FlightService.ReserveSeat:output_type -> flight.ReserveSeatResponse
+	22, // 35: flight.FlightService.ReleaseSeat:output_type -> flight.ReleaseSeatResponse
+	24, // 36: flight.FlightService.ConfirmSeat:output_type -> flight.ConfirmSeatResponse
+	26, // 37: flight.FlightService.CreateAircraft:output_type -> flight.CreateAircraftResponse
+	28, // 38: flight.FlightService.ListAircrafts:output_type -> flight.ListAircraftsResponse
+	30, // 39: flight.FlightService.AddAircraftSeats:output_type -> flight.AddAircraftSeatsResponse
+	27, // [27:40] is the sub-list for method output_type
+	14, // [14:27] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_flight_proto_init() }
@@ -1688,7 +1943,7 @@ func file_flight_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_flight_proto_rawDesc), len(file_flight_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,8 @@ const (
 	FlightService_GetFlightDetails_FullMethodName = "/flight.FlightService/GetFlightDetails"
 	FlightService_GetFlightSeats_FullMethodName   = "/flight.FlightService/GetFlightSeats"
 	FlightService_ListAirports_FullMethodName     = "/flight.FlightService/ListAirports"
+	FlightService_CancelFlight_FullMethodName     = "/flight.FlightService/CancelFlight"
+	FlightService_FindNextFlight_FullMethodName   = "/flight.FlightService/FindNextFlight"
 	FlightService_ReserveSeat_FullMethodName      = "/flight.FlightService/ReserveSeat"
 	FlightService_ReleaseSeat_FullMethodName      = "/flight.FlightService/ReleaseSeat"
 	FlightService_ConfirmSeat_FullMethodName      = "/flight.FlightService/ConfirmSeat"
@@ -41,6 +43,8 @@ type FlightServiceClient interface {
 	GetFlightDetails(ctx context.Context, in *GetFlightDetailsRequest, opts ...grpc.CallOption) (*GetFlightDetailsResponse, error)
 	GetFlightSeats(ctx context.Context, in *GetFlightSeatsRequest, opts ...grpc.CallOption) (*GetFlightSeatsResponse, error)
 	ListAirports(ctx context.Context, in *ListAirportsRequest, opts ...grpc.CallOption) (*ListAirportsResponse, error)
+	CancelFlight(ctx context.Context, in *CancelFlightRequest, opts ...grpc.CallOption) (*CancelFlightResponse, error)
+	FindNextFlight(ctx context.Context, in *FindNextFlightRequest, opts ...grpc.CallOption) (*FindNextFlightResponse, error)
 	ReserveSeat(ctx context.Context, in *ReserveSeatRequest, opts ...grpc.CallOption) (*ReserveSeatResponse, error)
 	ReleaseSeat(ctx context.Context, in *ReleaseSeatRequest, opts ...grpc.CallOption) (*ReleaseSeatResponse, error)
 	ConfirmSeat(ctx context.Context, in *ConfirmSeatRequest, opts ...grpc.CallOption) (*ConfirmSeatResponse, error)
@@ -107,6 +111,26 @@ func (c *flightServiceClient) ListAirports(ctx context.Context, in *ListAirports
 	return out, nil
 }
 
+func (c *flightServiceClient) CancelFlight(ctx context.Context, in *CancelFlightRequest, opts ...grpc.CallOption) (*CancelFlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFlightResponse)
+	err := c.cc.Invoke(ctx, FlightService_CancelFlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightServiceClient) FindNextFlight(ctx context.Context, in *FindNextFlightRequest, opts ...grpc.CallOption) (*FindNextFlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNextFlightResponse)
+	err := c.cc.Invoke(ctx, FlightService_FindNextFlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *flightServiceClient) ReserveSeat(ctx context.Context, in *ReserveSeatRequest, opts ...grpc.CallOption) (*ReserveSeatResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReserveSeatResponse)
@@ -176,6 +200,8 @@ type FlightServiceServer interface {
 	GetFlightDetails(context.Context, *GetFlightDetailsRequest) (*GetFlightDetailsResponse, error)
 	GetFlightSeats(context.Context, *GetFlightSeatsRequest) (*GetFlightSeatsResponse, error)
 	ListAirports(context.Context, *ListAirportsRequest) (*ListAirportsResponse, error)
+	CancelFlight(context.Context, *CancelFlightRequest) (*CancelFlightResponse, error)
+	FindNextFlight(context.Context, *FindNextFlightRequest) (*FindNextFlightResponse, error)
 	ReserveSeat(context.Context, *ReserveSeatRequest) (*ReserveSeatResponse, error)
 	ReleaseSeat(context.Context, *ReleaseSeatRequest) (*ReleaseSeatResponse, error)
 	ConfirmSeat(context.Context, *ConfirmSeatRequest) (*ConfirmSeatResponse, error)
@@ -207,6 +233,12 @@ func (UnimplementedFlightServiceServer) GetFlightSeats(context.Context, *GetFlig
 func (UnimplementedFlightServiceServer) ListAirports(context.Context, *ListAirportsRequest) (*ListAirportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAirports not implemented")
 }
+func (UnimplementedFlightServiceServer) CancelFlight(context.Context, *CancelFlightRequest) (*CancelFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFlight not implemented")
+}
+func (UnimplementedFlightServiceServer) FindNextFlight(context.Context, *FindNextFlightRequest) (*FindNextFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNextFlight not implemented")
+}
 func (UnimplementedFlightServiceServer) ReserveSeat(context.Context, *ReserveSeatRequest) (*ReserveSeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveSeat not implemented")
 }
@@ -336,6 +368,42 @@ func _FlightService_ListAirports_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FlightService_CancelFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightServiceServer).CancelFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlightService_CancelFlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightServiceServer).CancelFlight(ctx, req.(*CancelFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightService_FindNextFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNextFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightServiceServer).FindNextFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlightService_FindNextFlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightServiceServer).FindNextFlight(ctx, req.(*FindNextFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FlightService_ReserveSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReserveSeatRequest)
 	if err := dec(in); err != nil {
@@ -471,6 +539,14 @@ var FlightService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAirports",
 			Handler:    _FlightService_ListAirports_Handler,
 		},
+		{
+			MethodName: "CancelFlight",
+			Handler:    _FlightService_CancelFlight_Handler,
+		},
+		{
+			MethodName: "FindNextFlight",
+			Handler:    _FlightService_FindNextFlight_Handler,
+		},
 		{
 			MethodName: "ReserveSeat",
 			Handler:    _FlightService_ReserveSeat_Handler,
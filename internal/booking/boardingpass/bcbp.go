@@ -0,0 +1,119 @@
+package boardingpass
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Pass is everything printed on a boarding pass for one passenger.
+type Pass struct {
+	PNR            string
+	PassengerName  string
+	SeatNumber     string
+	FlightNumber   string
+	From           string
+	To             string
+	DepartureTime  time.Time
+	SequenceNumber int
+	BCBP           string
+}
+
+// EncodeBCBP builds the mandatory items of an IATA Resolution 792 bar coded
+// boarding pass (format "M", single leg, no conditional items). The flight
+// date is taken in DepartureTime's location, which must be the departure
+// airport's.
+func EncodeBCBP(p Pass) string {
+	carrier, number := splitFlightNumber(p.FlightNumber)
+
+	var b strings.Builder
+	b.WriteString("M1")
+	b.WriteString(fixed(bcbpName(p.PassengerName), 20))
+	b.WriteString("E")
+	b.WriteString(fixed(p.PNR, 7))
+	b.WriteString(fixed(p.From, 3))
+	b.WriteString(fixed(p.To, 3))
+	b.WriteString(fixed(carrier, 3))
+	b.WriteString(fixed(number, 5))
+	fmt.Fprintf(&b, "%03d", p.DepartureTime.YearDay())
+	b.WriteString("Y")
+	b.WriteString(bcbpSeat(p.SeatNumber))
+	fmt.Fprintf(&b, "%04d ", p.SequenceNumber%10000)
+	b.WriteString("1")
+	b.WriteString("00")
+	return b.String()
+}
+
+// bcbpName formats a name as SURNAME/GIVEN NAMES. Names are stored surname
+// first, the way saved passenger profiles are snapshotted.
+func bcbpName(name string) string {
+	parts := strings.Fields(strings.ToUpper(Transliterate(name)))
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	default:
+		return parts[0] + "/" + strings.Join(parts[1:], " ")
+	}
+}
+
+// bcbpSeat pads the row to three digits: "12A" becomes "012A".
+func bcbpSeat(seat string) string {
+	seat = strings.ToUpper(strings.TrimSpace(seat))
+	i := strings.IndexFunc(seat, func(r rune) bool { return !unicode.IsDigit(r) })
+	if i <= 0 {
+		return fixed(seat, 4)
+	}
+	row, letter := seat[:i], seat[i:]
+	if len(row) < 3 {
+		row = strings.Repeat("0", 3-len(row)) + row
+	}
+	return fixed(row+letter, 4)
+}
+
+// splitFlightNumber splits "SU1234" into the airline designator and the
+// numeric part padded to four digits.
+func splitFlightNumber(flightNumber string) (string, string) {
+	flightNumber = strings.ToUpper(strings.ReplaceAll(flightNumber, " ", ""))
+	if len(flightNumber) <= 2 {
+		return flightNumber, ""
+	}
+	carrier, number := flightNumber[:2], flightNumber[2:]
+	if len(number) < 4 {
+		number = strings.Repeat("0", 4-len(number)) + number
+	}
+	return carrier, number
+}
+
+func fixed(s string, width int) string {
+	if len(s) > width {
+		return s[:width]
+	}
+	return s + strings.Repeat(" ", width-len(s))
+}
+
+var cyrillicToLatin = map[rune]string{
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ё': "E", 'Ж': "ZH",
+	'З': "Z", 'И': "I", 'Й': "I", 'К': "K", 'Л': "L", 'М': "M", 'Н': "N", 'О': "O",
+	'П': "P", 'Р': "R", 'С': "S", 'Т': "T", 'У': "U", 'Ф': "F", 'Х': "KH", 'Ц': "TS",
+	'Ч': "CH", 'Ш': "SH", 'Щ': "SHCH", 'Ъ': "IE", 'Ы': "Y", 'Ь': "", 'Э': "E", 'Ю': "IU",
+	'Я': "IA",
+}
+
+// Transliterate maps Cyrillic letters to Latin (ICAO Doc 9303) and drops any
+// other non-ASCII character, BCBP fields are fixed width ASCII.
+func Transliterate(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r < unicode.MaxASCII {
+			b.WriteRune(r)
+			continue
+		}
+		if latin, ok := cyrillicToLatin[unicode.ToUpper(r)]; ok {
+			b.WriteString(latin)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,48 @@
+package boardingpass
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestEncodeBCBP(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+
+	// 1 March 22:30 UTC is already 2 March in Moscow, day 061 rather than 060.
+	departure := time.Date(2026, time.March, 1, 22, 30, 0, 0, time.UTC).In(moscow)
+
+	got := EncodeBCBP(Pass{
+		PNR:            "ABC123",
+		PassengerName:  "Иванов Иван",
+		SeatNumber:     "7a",
+		FlightNumber:   "SU 12",
+		From:           "SVO",
+		To:             "LED",
+		DepartureTime:  departure,
+		SequenceNumber: 25,
+	})
+
+	want := "M1" + // format code, number of legs
+		"IVANOV/IVAN         " + // passenger name, 20
+		"E" + // electronic ticket indicator
+		"ABC123 " + // PNR, 7
+		"SVO" + "LED" + // from and to airports, 3 each
+		"SU " + // operating carrier, 3
+		"0012 " + // flight number, 5
+		"061" + // Julian date of the flight
+		"Y" + // compartment code
+		"007A" + // seat, 4
+		"0025 " + // check-in sequence number, 5
+		"1" + // passenger status
+		"00" // size of the conditional items
+	if got != want {
+		t.Errorf("EncodeBCBP() =\n%q\nwant\n%q", got, want)
+	}
+	if len(got) != 60 {
+		t.Errorf("EncodeBCBP() length = %d, want 60", len(got))
+	}
+}
@@ -0,0 +1,87 @@
+package boardingpass
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/pdf417"
+	"github.com/jung-kurt/gofpdf"
+	"image"
+	"image/draw"
+	"image/png"
+)
+
+const (
+	// pdf417SecurityLevel is the error correction level IATA recommends for BCBP.
+	pdf417SecurityLevel = 2
+	barcodeScale        = 3
+)
+
+// RenderPNG returns the PDF417 barcode of the pass as a PNG image.
+func RenderPNG(p Pass) ([]byte, error) {
+	img, err := barcodeImage(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderPDF renders one A6 landscape page per pass.
+func RenderPDF(passes []Pass) ([]byte, error) {
+	pdf := gofpdf.New("L", "mm", "A6", "")
+	pdf.SetMargins(8, 8, 8)
+	pdf.SetAutoPageBreak(false, 0)
+
+	for i, p := range passes {
+		pngBytes, err := RenderPNG(p)
+		if err != nil {
+			return nil, err
+		}
+
+		pdf.AddPage()
+
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.CellFormat(0, 8, "BOARDING PASS", "", 1, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(0, 6, fmt.Sprintf("Passenger: %s", bcbpName(p.PassengerName)), "", 1, "L", false, 0, "")
+		pdf.CellFormat(0, 6, fmt.Sprintf("Flight: %s   %s -> %s", p.FlightNumber, p.From, p.To), "", 1, "L", false, 0, "")
+		pdf.CellFormat(0, 6, fmt.Sprintf("Departure: %s UTC", p.DepartureTime.UTC().Format("02 Jan 2006 15:04")), "", 1, "L", false, 0, "")
+		pdf.CellFormat(0, 6, fmt.Sprintf("Seat: %s   Seq: %04d   Booking ref: %s", p.SeatNumber, p.SequenceNumber, p.PNR), "", 1, "L", false, 0, "")
+
+		name := fmt.Sprintf("barcode-%d", i)
+		opts := gofpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader(name, opts, bytes.NewReader(pngBytes))
+		pdf.ImageOptions(name, 8, 52, 90, 0, false, opts, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func barcodeImage(p Pass) (image.Image, error) {
+	code, err := pdf417.Encode(p.BCBP, pdf417SecurityLevel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode barcode: %w", err)
+	}
+
+	bounds := code.Bounds()
+	scaled, err := barcode.Scale(code, bounds.Dx()*barcodeScale, bounds.Dy()*barcodeScale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scale barcode: %w", err)
+	}
+
+	// Redraw as 8-bit grayscale, gofpdf cannot embed the 16-bit PNG the
+	// barcode color model would produce.
+	img := image.NewGray(scaled.Bounds())
+	draw.Draw(img, img.Bounds(), scaled, scaled.Bounds().Min, draw.Src)
+	return img, nil
+}
@@ -2,10 +2,15 @@ package flight
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	flightv1 "github.com/squ1ky/flyte/gen/go/flight"
+	"github.com/squ1ky/flyte/internal/booking/domain"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 )
 
@@ -27,12 +32,97 @@ func NewClient(addr string, timeout time.Duration) (*Client, error) {
 	}, nil
 }
 
+func (c *Client) GetFlight(ctx context.Context, flightID int64) (*domain.Flight, error) {
+	resp, err := c.api.GetFlightDetails(ctx, &flightv1.GetFlightDetailsRequest{
+		FlightId: flightID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("flight %d: %w", flightID, domain.ErrFlightNotFound)
+		}
+		return nil, fmt.Errorf("failed to get flight: %w", err)
+	}
+
+	return mapFlight(resp.GetFlight()), nil
+}
+
+func mapFlight(f *flightv1.Flight) *domain.Flight {
+	return &domain.Flight{
+		ID:               f.GetId(),
+		FlightNumber:     f.GetFlightNumber(),
+		DepartureAirport: f.GetDepartureAirport(),
+		ArrivalAirport:   f.GetArrivalAirport(),
+		DepartureTime:    f.GetDepartureTime().AsTime(),
+		ArrivalTime:      f.GetArrivalTime().AsTime(),
+		BasePriceCents:   f.GetBasePriceCents(),
+		Status:           f.GetStatus(),
+
+		DepartureTimezone: f.GetDepartureTimezone(),
+	}
+}
+
+// FindNextFlight returns the earliest flight on the route departing after
+// the given time with at least passengers free seats.
+func (c *Client) FindNextFlight(
+	ctx context.Context,
+	from, to string,
+	after time.Time,
+	passengers int,
+	excludeFlightID int64,
+) (*domain.Flight, error) {
+	resp, err := c.api.FindNextFlight(ctx, &flightv1.FindNextFlightRequest{
+		FromAirport:     from,
+		ToAirport:       to,
+		DepartureAfter:  timestamppb.New(after),
+		PassengerCount:  int32(passengers),
+		ExcludeFlightId: excludeFlightID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%s-%s: %w", from, to, domain.ErrFlightNotFound)
+		}
+		return nil, fmt.Errorf("failed to find next flight: %w", err)
+	}
+
+	return mapFlight(resp.GetFlight()), nil
+}
+
+func (c *Client) GetSeats(ctx context.Context, flightID int64) ([]domain.Seat, error) {
+	resp, err := c.api.GetFlightSeats(ctx, &flightv1.GetFlightSeatsRequest{
+		FlightId: flightID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("flight %d: %w", flightID, domain.ErrFlightNotFound)
+		}
+		return nil, fmt.Errorf("failed to get flight seats: %w", err)
+	}
+
+	seats := make([]domain.Seat, 0, len(resp.GetSeats()))
+	for _, seat := range resp.GetSeats() {
+		seats = append(seats, domain.Seat{
+			SeatNumber:      seat.GetSeatNumber(),
+			IsBooked:        seat.GetIsBooked(),
+			PriceMultiplier: seat.GetPriceMultiplier(),
+		})
+	}
+	return seats, nil
+}
+
 func (c *Client) ReserveSeat(ctx context.Context, flightID int64, seatNumber string) error {
 	_, err := c.api.ReserveSeat(ctx, &flightv1.ReserveSeatRequest{
 		FlightId:   flightID,
 		SeatNumber: seatNumber,
 	})
 	if err != nil {
+		switch status.Code(err) {
+		case codes.AlreadyExists:
+			return fmt.Errorf("failed to reserve seat: %w", domain.ErrSeatAlreadyBooked)
+		case codes.NotFound:
+			return fmt.Errorf("failed to reserve seat: %w", domain.ErrSeatNotFound)
+		case codes.FailedPrecondition:
+			return fmt.Errorf("failed to reserve seat: %w", domain.ErrFlightCancelled)
+		}
 		return fmt.Errorf("failed to reserve seat: %w", err)
 	}
 	return nil
@@ -57,8 +147,31 @@ func (c *Client) ConfirmSeat(ctx context.Context, flightID int64, seatNumber str
 
 	_, err := c.api.ConfirmSeat(ctx, req)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("failed to confirm seat: %w", domain.ErrSeatNotFound)
+		}
 		return fmt.Errorf("failed to confirm seat: %w", err)
 	}
 
 	return nil
 }
+
+func (c *Client) ReleaseSeats(ctx context.Context, flightID int64, seatNumbers []string) error {
+	var errs []error
+	for _, seat := range seatNumbers {
+		if err := c.ReleaseSeat(ctx, flightID, seat); err != nil {
+			errs = append(errs, fmt.Errorf("seat %s: %w", seat, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Client) ConfirmSeats(ctx context.Context, flightID int64, seatNumbers []string) error {
+	var errs []error
+	for _, seat := range seatNumbers {
+		if err := c.ConfirmSeat(ctx, flightID, seat); err != nil {
+			errs = append(errs, fmt.Errorf("seat %s: %w", seat, err))
+		}
+	}
+	return errors.Join(errs...)
+}
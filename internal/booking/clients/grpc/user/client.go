@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	userv1 "github.com/squ1ky/flyte/gen/go/user"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"time"
+)
+
+type Client struct {
+	api userv1.UserServiceClient
+}
+
+func NewClient(addr string, timeout time.Duration) (*Client, error) {
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc connection: %w", err)
+	}
+
+	return &Client{
+		api: userv1.NewUserServiceClient(conn),
+	}, nil
+}
+
+// GetPassengers returns the saved passenger profiles of the user, so any
+// profile in the result is known to belong to them.
+func (c *Client) GetPassengers(ctx context.Context, userID int64) ([]domain.PassengerProfile, error) {
+	resp, err := c.api.GetPassengers(ctx, &userv1.GetPassengersRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passengers: %w", err)
+	}
+
+	profiles := make([]domain.PassengerProfile, 0, len(resp.GetPassengers()))
+	for _, p := range resp.GetPassengers() {
+		profiles = append(profiles, domain.PassengerProfile{
+			ID:             p.GetId(),
+			FirstName:      p.GetFirstName(),
+			LastName:       p.GetLastName(),
+			MiddleName:     p.GetMiddleName(),
+			DocumentNumber: p.GetDocumentNumber(),
+			DocumentType:   p.GetDocumentType(),
+			Citizenship:    p.GetCitizenship(),
+		})
+	}
+
+	return profiles, nil
+}
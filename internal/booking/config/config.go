@@ -12,8 +12,13 @@ type Config struct {
 	DB            DBConfig
 	Kafka         KafkaConfig
 	FlightService FlightServiceConfig
+	UserService   UserServiceConfig
 	Cleaner       CleanerConfig
 	Outbox        OutboxConfig
+	CheckIn       CheckInConfig
+	Ticket        TicketConfig
+	Saga          SagaConfig
+	Leader        LeaderConfig
 }
 
 type GRPCConfig struct {
@@ -35,19 +40,58 @@ type KafkaConfig struct {
 	TopicRequests string   `env:"KAFKA_TOPIC_PAYMENT_REQUESTS" env-default:"payment_requests"`
 	TopicResults  string   `env:"KAFKA_TOPIC_PAYMENT_RESULTS" env-default:"payment_results"`
 	GroupID       string   `env:"BOOKING_KAFKA_GROUP_ID" env-default:"booking_service_group"`
+
+	TopicFlightEvents string `env:"KAFKA_TOPIC_FLIGHT_EVENTS" env-default:"flight_events"`
+	// RetryDelays are the delays of the consumers' retry topics, a message
+	// that still fails after the last one is dead-lettered.
+	RetryDelays []time.Duration `env:"KAFKA_RETRY_DELAYS" env-default:"10s,1m,10m"`
 }
 
 type FlightServiceConfig struct {
 	Address string `env:"FLIGHT_SERVICE_ADDR" env-required:"true"`
 }
 
+type UserServiceConfig struct {
+	Address string `env:"USER_SERVICE_ADDR" env-required:"true"`
+}
+
 type CleanerConfig struct {
 	Interval   time.Duration `env:"BOOKING_CLEANER_INTERVAL" env-default:"1m"`
 	BookingTTL time.Duration `env:"RESERVATION_TTL" env-required:"true"`
 }
 
 type OutboxConfig struct {
-	Interval time.Duration `env:"BOOKING_OUTBOX_INTERVAL" env-default:"5s"`
+	Interval    time.Duration `env:"BOOKING_OUTBOX_INTERVAL" env-default:"5s"`
+	BatchSize   int           `env:"BOOKING_OUTBOX_BATCH_SIZE" env-default:"50"`
+	MaxAttempts int           `env:"BOOKING_OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+	BaseBackoff time.Duration `env:"BOOKING_OUTBOX_BASE_BACKOFF" env-default:"1s"`
+	MaxBackoff  time.Duration `env:"BOOKING_OUTBOX_MAX_BACKOFF" env-default:"10m"`
+}
+
+type CheckInConfig struct {
+	OpensBefore  time.Duration `env:"BOOKING_CHECKIN_OPENS_BEFORE" env-default:"24h"`
+	ClosesBefore time.Duration `env:"BOOKING_CHECKIN_CLOSES_BEFORE" env-default:"1h"`
+}
+
+type TicketConfig struct {
+	AirlineCode string `env:"BOOKING_TICKET_AIRLINE_CODE" env-default:"555"`
+}
+
+// SagaConfig controls the booking saga. A step not finished within
+// StepTimeout is taken over by the recovery worker, failed steps are retried
+// with backoff until they succeed.
+type SagaConfig struct {
+	Interval    time.Duration `env:"BOOKING_SAGA_INTERVAL" env-default:"5s"`
+	BatchSize   int           `env:"BOOKING_SAGA_BATCH_SIZE" env-default:"50"`
+	StepTimeout time.Duration `env:"BOOKING_SAGA_STEP_TIMEOUT" env-default:"1m"`
+	BaseBackoff time.Duration `env:"BOOKING_SAGA_BASE_BACKOFF" env-default:"1s"`
+	MaxBackoff  time.Duration `env:"BOOKING_SAGA_MAX_BACKOFF" env-default:"5m"`
+}
+
+// LeaderConfig controls the election of the replica running the background
+// workers.
+type LeaderConfig struct {
+	Interval time.Duration `env:"BOOKING_LEADER_INTERVAL" env-default:"5s"`
 }
 
 func Load() (*Config, error) {
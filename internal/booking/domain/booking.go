@@ -1,34 +1,120 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type Booking struct {
-	ID                string        `db:"id"`
-	UserID            int64         `db:"user_id"`
-	FlightID          int64         `db:"flight_id"`
-	SeatNumber        string        `db:"seat_number"`
-	PassengerName     string        `db:"passenger_name"`
-	PassengerPassport string        `db:"passenger_passport"`
-	PriceCents        int64         `db:"price_cents"`
-	Currency          string        `db:"currency"`
-	Status            BookingStatus `db:"status"`
-	CreatedAt         time.Time     `db:"created_at"`
-	UpdatedAt         time.Time     `db:"updated_at"`
+	ID         string        `db:"id"`
+	PNR        string        `db:"pnr"`
+	UserID     int64         `db:"user_id"`
+	FlightID   int64         `db:"flight_id"`
+	PriceCents int64         `db:"price_cents"`
+	Currency   string        `db:"currency"`
+	Status     BookingStatus `db:"status"`
+	CreatedAt  time.Time     `db:"created_at"`
+	UpdatedAt  time.Time     `db:"updated_at"`
+
+	// DepartureTime is a snapshot from the flight service, nil for old bookings.
+	DepartureTime *time.Time `db:"departure_time"`
+
+	// IdempotencyKey is set when the client sent an Idempotency-Key, RequestHash
+	// fingerprints the original request so a reused key can be detected.
+	IdempotencyKey *string `db:"idempotency_key"`
+	RequestHash    *string `db:"request_hash"`
+
+	Items []BookingItem `db:"-"`
+}
+
+// BookingItem keeps a snapshot of the passenger data at booking time, later
+// edits of a saved profile in the user service do not affect it.
+type BookingItem struct {
+	ID                string `db:"id"`
+	BookingID         string `db:"booking_id"`
+	SeatNumber        string `db:"seat_number"`
+	PassengerID       *int64 `db:"passenger_id"`
+	PassengerName     string `db:"passenger_name"`
+	PassengerPassport string `db:"passenger_passport"`
+	DocumentType      string `db:"document_type"`
+	Citizenship       string `db:"citizenship"`
+	PriceCents        int64  `db:"price_cents"`
+
+	// TicketNumber is read from tickets, nil until the booking is paid.
+	TicketNumber *string `db:"ticket_number"`
+}
+
+// IsPaid reports whether the customer has been charged for the booking and
+// has not been refunded yet.
+func (b *Booking) IsPaid() bool {
+	return b.Status == StatusPaid || b.Status == StatusCheckedIn
+}
+
+func (b *Booking) SeatNumbers() []string {
+	seats := make([]string, 0, len(b.Items))
+	for _, item := range b.Items {
+		seats = append(seats, item.SeatNumber)
+	}
+	return seats
+}
+
+// HasPassengerSurname reports whether any passenger on the booking carries
+// the given surname, compared case-insensitively. Names are stored surname
+// first, only the first name part is the surname; first and middle names do
+// not unlock the booking.
+func (b *Booking) HasPassengerSurname(lastName string) bool {
+	lastName = strings.TrimSpace(lastName)
+	if lastName == "" {
+		return false
+	}
+	for _, item := range b.Items {
+		parts := strings.Fields(item.PassengerName)
+		if len(parts) > 0 && strings.EqualFold(parts[0], lastName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Booking) Item(seatNumber string) (*BookingItem, bool) {
+	for i := range b.Items {
+		if b.Items[i].SeatNumber == seatNumber {
+			return &b.Items[i], true
+		}
+	}
+	return nil, false
 }
 
 type BookingStatus string
 
 const (
-	StatusPending   BookingStatus = "PENDING"
-	StatusPaid      BookingStatus = "PAID"
-	StatusCancelled BookingStatus = "CANCELLED"
-	StatusFailed    BookingStatus = "FAILED"
-	StatusTimeout   BookingStatus = "TIMEOUT"
+	StatusPending       BookingStatus = "PENDING"
+	StatusPaid          BookingStatus = "PAID"
+	StatusCheckedIn     BookingStatus = "CHECKED_IN"
+	StatusCancelled     BookingStatus = "CANCELLED"
+	StatusFailed        BookingStatus = "FAILED"
+	StatusTimeout       BookingStatus = "TIMEOUT"
+	StatusRefundPending BookingStatus = "REFUND_PENDING"
+	StatusRefunded      BookingStatus = "REFUNDED"
+
+	// StatusFlightCancelled is set when the flight itself is cancelled, paid
+	// bookings are refunded from it.
+	StatusFlightCancelled BookingStatus = "FLIGHT_CANCELLED"
 )
 
+func (s BookingStatus) IsValid() bool {
+	switch s {
+	case StatusPending, StatusPaid, StatusCheckedIn, StatusCancelled, StatusFailed,
+		StatusTimeout, StatusRefundPending, StatusRefunded, StatusFlightCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s BookingStatus) IsTerminal() bool {
 	switch s {
-	case StatusPaid, StatusCancelled, StatusFailed, StatusTimeout:
+	case StatusCancelled, StatusFailed, StatusTimeout, StatusRefunded:
 		return true
 	default:
 		return false
@@ -0,0 +1,32 @@
+package domain
+
+import "testing"
+
+func TestHasPassengerSurname(t *testing.T) {
+	b := &Booking{Items: []BookingItem{
+		{PassengerName: "Ivanov Ivan Petrovich"},
+		{PassengerName: "Smirnova Anna"},
+	}}
+
+	tests := []struct {
+		name     string
+		lastName string
+		want     bool
+	}{
+		{name: "surname", lastName: "Ivanov", want: true},
+		{name: "surname of another passenger", lastName: "smirnova", want: true},
+		{name: "surname with spaces", lastName: "  IVANOV ", want: true},
+		{name: "first name", lastName: "Ivan", want: false},
+		{name: "middle name", lastName: "Petrovich", want: false},
+		{name: "first name of another passenger", lastName: "Anna", want: false},
+		{name: "empty", lastName: " ", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := b.HasPassengerSurname(tt.lastName); got != tt.want {
+				t.Errorf("HasPassengerSurname(%q) = %v, want %v", tt.lastName, got, tt.want)
+			}
+		})
+	}
+}
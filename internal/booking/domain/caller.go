@@ -0,0 +1,17 @@
+package domain
+
+const RoleAdmin = "admin"
+
+// Caller is the authenticated user on whose behalf a request is made.
+type Caller struct {
+	UserID int64
+	Role   string
+}
+
+func (c Caller) IsAdmin() bool {
+	return c.Role == RoleAdmin
+}
+
+func (c Caller) CanAccess(b *Booking) bool {
+	return c.IsAdmin() || b.UserID == c.UserID
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// BoardingPass is issued for every passenger of a booking at check-in. The
+// printable pass is rebuilt from it together with the booking and flight.
+type BoardingPass struct {
+	BookingItemID  string    `db:"booking_item_id"`
+	BookingID      string    `db:"booking_id"`
+	FlightID       int64     `db:"flight_id"`
+	SequenceNumber int       `db:"sequence_number"`
+	IssuedAt       time.Time `db:"issued_at"`
+}
+
+// CheckInWindow is the period before departure when online check-in is open.
+type CheckInWindow struct {
+	OpensBefore  time.Duration
+	ClosesBefore time.Duration
+}
+
+func (w CheckInWindow) Check(departure, now time.Time) error {
+	if now.Before(departure.Add(-w.OpensBefore)) {
+		return ErrCheckInNotOpen
+	}
+	if !now.Before(departure.Add(-w.ClosesBefore)) {
+		return ErrCheckInClosed
+	}
+	return nil
+}
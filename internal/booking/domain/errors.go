@@ -5,5 +5,41 @@ import (
 )
 
 var (
-	ErrBookingNotFound = errors.New("booking not found")
+	ErrBookingNotFound     = errors.New("booking not found")
+	ErrBookingAccessDenied = errors.New("booking belongs to another user")
+	ErrStatusChanged       = errors.New("booking status changed concurrently")
+	ErrNotCancellable      = errors.New("booking cannot be cancelled in its current status")
+	ErrSeatNotChangeable   = errors.New("booking seats cannot be changed in its current status")
+	ErrSeatNotInBooking    = errors.New("seat is not part of the booking")
+	ErrSeatChangePending   = errors.New("another seat change is awaiting payment")
+	ErrSeatChangeNotFound  = errors.New("seat change not found")
+	ErrSeatChangeSettled   = errors.New("seat change was already settled")
+	ErrNotCheckInable      = errors.New("booking cannot be checked in in its current status")
+	ErrCheckInNotOpen      = errors.New("online check-in is not open yet")
+	ErrCheckInClosed       = errors.New("online check-in is closed")
+	ErrNotCheckedIn        = errors.New("booking is not checked in")
+	ErrNotTicketed         = errors.New("booking has no tickets issued")
+
+	ErrRebookingOfferNotFound = errors.New("booking has no rebooking offer")
+	ErrRebookingOfferTaken    = errors.New("rebooking offer was already accepted")
+	ErrNoSeatsForRebooking    = errors.New("not enough free seats on the offered flight")
+
+	ErrIdempotencyKeyReused = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyExists = errors.New("booking with this idempotency key already exists")
+	ErrPNRTaken             = errors.New("booking reference already taken")
+
+	ErrFlightNotFound    = errors.New("flight not found")
+	ErrFlightCancelled   = errors.New("flight is cancelled")
+	ErrSeatNotFound      = errors.New("seat not found")
+	ErrSeatAlreadyBooked = errors.New("seat already booked")
+	ErrPriceChanged      = errors.New("price has changed since the quote")
+
+	ErrPassengerNotFound = errors.New("passenger profile not found")
+
+	ErrAdminOnly           = errors.New("operation is available to admins only")
+	ErrOutboxEventNotFound = errors.New("outbox event not found")
+
+	ErrSagaNotFound     = errors.New("booking saga not found")
+	ErrSagaStateChanged = errors.New("booking saga state changed concurrently")
+	ErrSagaBusy         = errors.New("booking saga step is already running")
 )
@@ -0,0 +1,20 @@
+package events
+
+import (
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"time"
+)
+
+const EventTypeFlightCancelled = eventcodec.EventTypeFlightCancelled
+
+// FlightCancelledEvent is published by the flight service when a flight is
+// cancelled.
+type FlightCancelledEvent struct {
+	FlightID         int64     `json:"flight_id"`
+	FlightNumber     string    `json:"flight_number"`
+	DepartureAirport string    `json:"departure_airport"`
+	ArrivalAirport   string    `json:"arrival_airport"`
+	DepartureTime    time.Time `json:"departure_time"`
+	Reason           string    `json:"reason,omitempty"`
+	CancelledAt      time.Time `json:"cancelled_at"`
+}
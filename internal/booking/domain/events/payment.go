@@ -1,23 +1,40 @@
 package events
 
+import "github.com/squ1ky/flyte/pkg/eventcodec"
+
 type PaymentStatus string
 
 const (
-	PaymentStatusSuccess PaymentStatus = "SUCCESS"
-	PaymentStatusFailed  PaymentStatus = "FAILED"
+	// PaymentStatusAuthorized answers the initial payment of a booking, the
+	// money is held until the booking captures or voids it.
+	PaymentStatusAuthorized PaymentStatus = "AUTHORIZED"
+	PaymentStatusSuccess    PaymentStatus = "SUCCESS"
+	PaymentStatusFailed     PaymentStatus = "FAILED"
 )
 
-type PaymentRequestEvent struct {
-	BookingID   string `json:"booking_id"`
-	UserID      int64  `json:"user_id"`
-	AmountCents int64  `json:"amount_cents"`
-	Currency    string `json:"currency"`
-}
+const (
+	EventTypePaymentRequest = eventcodec.EventTypePaymentRequest
+	EventTypePaymentResult  = eventcodec.EventTypePaymentResult
+	EventTypeRefundRequest  = eventcodec.EventTypeRefundRequest
+	EventTypeRefundResult   = eventcodec.EventTypeRefundResult
 
+	EventTypePaymentCaptureRequest = eventcodec.EventTypePaymentCaptureRequest
+	EventTypePaymentCaptureResult  = eventcodec.EventTypePaymentCaptureResult
+	EventTypePaymentVoidRequest    = eventcodec.EventTypePaymentVoidRequest
+	EventTypePaymentVoidResult     = eventcodec.EventTypePaymentVoidResult
+)
+
+// PaymentResultEvent is shared by payment, refund, capture and void results;
+// the Kafka event_type header tells them apart.
 type PaymentResultEvent struct {
 	BookingID    string        `json:"booking_id"`
 	PaymentID    string        `json:"payment_id"`
+	Reference    string        `json:"reference,omitempty"`
 	Status       PaymentStatus `json:"status"`
 	ErrorMessage string        `json:"error_message,omitempty"`
 	ProcessedAt  string        `json:"processed_at"`
+
+	// RefundKey and AmountCents are set on refund results.
+	RefundKey   string `json:"refund_key,omitempty"`
+	AmountCents int64  `json:"amount_cents,omitempty"`
 }
@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+const DefaultCurrency = "RUB"
+
+type Flight struct {
+	ID               int64
+	FlightNumber     string
+	DepartureAirport string
+	ArrivalAirport   string
+	DepartureTime    time.Time
+	ArrivalTime      time.Time
+	BasePriceCents   int64
+	Status           string
+	// DepartureTimezone is the IANA time zone of the departure airport.
+	DepartureTimezone string
+}
+
+// LocalDepartureTime returns the departure time at the departure airport.
+// An unknown time zone falls back to UTC.
+func (f *Flight) LocalDepartureTime() time.Time {
+	loc, err := time.LoadLocation(f.DepartureTimezone)
+	if err != nil || f.DepartureTimezone == "" {
+		return f.DepartureTime.UTC()
+	}
+	return f.DepartureTime.In(loc)
+}
+
+type Seat struct {
+	SeatNumber      string
+	IsBooked        bool
+	PriceMultiplier float64
+}
+
+type Quote struct {
+	FlightID      int64
+	DepartureTime time.Time
+	Currency      string
+	TotalCents    int64
+	Items         []QuoteItem
+}
+
+type QuoteItem struct {
+	SeatNumber string
+	PriceCents int64
+}
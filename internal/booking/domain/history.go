@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+type Actor string
+
+const (
+	ActorUser          Actor = "USER"
+	ActorPaymentEvent  Actor = "PAYMENT_EVENT"
+	ActorCleanerWorker Actor = "CLEANER_WORKER"
+	ActorSystem        Actor = "SYSTEM"
+	ActorFlightEvent   Actor = "FLIGHT_EVENT"
+)
+
+// StatusChange describes who moved a booking to a new status and why.
+type StatusChange struct {
+	Actor   Actor
+	ActorID string
+	Reason  string
+}
+
+type StatusHistoryEntry struct {
+	ID         int64          `db:"id"`
+	BookingID  string         `db:"booking_id"`
+	FromStatus *BookingStatus `db:"from_status"`
+	ToStatus   BookingStatus  `db:"to_status"`
+	Actor      Actor          `db:"actor"`
+	ActorID    *string        `db:"actor_id"`
+	Reason     string         `db:"reason"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
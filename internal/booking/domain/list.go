@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// BookingFilter narrows down a user's bookings. Zero values mean "no filter".
+type BookingFilter struct {
+	UserID        int64
+	Statuses      []BookingStatus
+	FlightID      int64
+	DepartureFrom *time.Time
+	DepartureTo   *time.Time
+	OldestFirst   bool
+	Limit         int
+	After         *BookingCursor
+}
+
+// BookingCursor points at the last booking of a page, ordered by created_at then id.
+type BookingCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+func CursorFor(b *Booking) *BookingCursor {
+	return &BookingCursor{CreatedAt: b.CreatedAt, ID: b.ID}
+}
+
+func (c *BookingCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeBookingCursor(s string) (*BookingCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	return &BookingCursor{CreatedAt: time.Unix(0, n).UTC(), ID: id}, nil
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "strings"
+
+// PassengerProfile is a passenger saved in the user service.
+type PassengerProfile struct {
+	ID             int64
+	FirstName      string
+	LastName       string
+	MiddleName     string
+	DocumentNumber string
+	DocumentType   string
+	Citizenship    string
+}
+
+func (p *PassengerProfile) FullName() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{p.LastName, p.FirstName, p.MiddleName} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
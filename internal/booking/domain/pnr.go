@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+const (
+	PNRLength = 6
+	// pnrAlphabet leaves out 0/O and 1/I so a locator can be read over the phone.
+	pnrAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// NewPNR generates a random record locator. Uniqueness is enforced by the
+// database, callers retry on ErrPNRTaken.
+func NewPNR() (string, error) {
+	max := big.NewInt(int64(len(pnrAlphabet)))
+	code := make([]byte, PNRLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate pnr: %w", err)
+		}
+		code[i] = pnrAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+type RebookingOfferStatus string
+
+const (
+	RebookingOffered  RebookingOfferStatus = "OFFERED"
+	RebookingAccepted RebookingOfferStatus = "ACCEPTED"
+)
+
+// RebookingOffer is made to every booking of a cancelled flight when another
+// flight on the same route can take all of its passengers.
+type RebookingOffer struct {
+	BookingID     string               `db:"booking_id"`
+	FlightID      int64                `db:"flight_id"`
+	DepartureTime time.Time            `db:"departure_time"`
+	Status        RebookingOfferStatus `db:"status"`
+	NewBookingID  *string              `db:"new_booking_id"`
+	CreatedAt     time.Time            `db:"created_at"`
+	UpdatedAt     time.Time            `db:"updated_at"`
+}
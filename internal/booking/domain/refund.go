@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// NewRefundKey returns the key of a refund the booking requests. Every request
+// gets a key of its own, so a refund that failed can be requested again.
+func NewRefundKey(bookingID string) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate refund key: %w", err)
+	}
+	return bookingID + "/" + hex.EncodeToString(b), nil
+}
+
+// IsBookingRefundKey reports whether key belongs to a refund the booking
+// requested itself. Refunds made over the payment API use keys of their own
+// and do not move the booking; results without a key predate refund keys.
+func IsBookingRefundKey(bookingID, key string) bool {
+	return key == "" || key == bookingID || strings.HasPrefix(key, bookingID+"/")
+}
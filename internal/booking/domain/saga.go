@@ -0,0 +1,95 @@
+package domain
+
+import "time"
+
+// Saga drives the steps of placing a booking across services: the seats are
+// reserved in the flight service, the booking waits for its payment to be
+// authorized, and the seats are confirmed before the payment is captured.
+// When the booking cannot go on, the payment is voided and the reserved seats
+// are released instead. The saga is persisted before the first step,
+// so the steps of a crashed replica are picked up again by another one.
+type Saga struct {
+	ID        string    `db:"id"`
+	BookingID *string   `db:"booking_id"`
+	UserID    int64     `db:"user_id"`
+	FlightID  int64     `db:"flight_id"`
+	State     SagaState `db:"state"`
+
+	// ReservedSeats are the seats reserved before the booking was stored.
+	// Once it is, the booking's own seats are the ones to confirm or release.
+	ReservedSeats []string `db:"-"`
+
+	// FailureReason tells why the saga is compensating, LastError why its
+	// current step failed last time.
+	FailureReason *string   `db:"failure_reason"`
+	Attempts      int       `db:"attempts"`
+	LastError     *string   `db:"last_error"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+type SagaState string
+
+const (
+	SagaReservingSeats  SagaState = "RESERVING_SEATS"
+	SagaAwaitingPayment SagaState = "AWAITING_PAYMENT"
+
+	// SagaConfirmingSeats confirms the seats of a booking whose payment is
+	// authorized. Once they are, the booking is paid and the capture requested
+	// in one go (COMPLETED); a seat that is gone voids the payment.
+	SagaConfirmingSeats SagaState = "CONFIRMING_SEATS"
+	SagaCompleted       SagaState = "COMPLETED"
+
+	// SagaCompensating releases the reserved seats, it is retried until the
+	// flight service accepts the release.
+	SagaCompensating SagaState = "COMPENSATING"
+	SagaCompensated  SagaState = "COMPENSATED"
+)
+
+func (s SagaState) IsValid() bool {
+	switch s {
+	case SagaReservingSeats, SagaAwaitingPayment, SagaConfirmingSeats, SagaCompleted,
+		SagaCompensating, SagaCompensated:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasPendingStep reports whether the saga has a step to run on its own,
+// AWAITING_PAYMENT waits for the payment result instead.
+func (s SagaState) HasPendingStep() bool {
+	switch s {
+	case SagaReservingSeats, SagaConfirmingSeats, SagaCompensating:
+		return true
+	default:
+		return false
+	}
+}
+
+// SagaStateAfter returns the state a saga awaiting the payment moves to when
+// its booking leaves PENDING, ok is false when the status change does not
+// touch the saga. The saga pays the booking itself once the seats are
+// confirmed.
+func SagaStateAfter(from, to BookingStatus) (SagaState, bool) {
+	if from != StatusPending {
+		return "", false
+	}
+
+	switch to {
+	case StatusCancelled, StatusFailed, StatusTimeout:
+		return SagaCompensating, true
+	case StatusFlightCancelled:
+		// The seats went away with the flight, there is nothing to release.
+		return SagaCompensated, true
+	default:
+		return "", false
+	}
+}
+
+type SagaFilter struct {
+	State     SagaState
+	BookingID string
+	Limit     int
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+type SeatChangeStatus string
+
+const (
+	// SeatChangeAwaitingPayment holds the new seat while the surcharge is
+	// charged, the booking keeps its old seat and price until then. The
+	// surcharge of a pending booking is only requested once the booking is
+	// paid, it is charged after the booking's own payment.
+	SeatChangeAwaitingPayment SeatChangeStatus = "AWAITING_PAYMENT"
+	SeatChangeApplied         SeatChangeStatus = "APPLIED"
+	SeatChangeFailed          SeatChangeStatus = "FAILED"
+)
+
+type SeatChange struct {
+	ID            string           `db:"id"`
+	BookingID     string           `db:"booking_id"`
+	BookingItemID string           `db:"booking_item_id"`
+	OldSeatNumber string           `db:"old_seat_number"`
+	NewSeatNumber string           `db:"new_seat_number"`
+	OldPriceCents int64            `db:"old_price_cents"`
+	NewPriceCents int64            `db:"new_price_cents"`
+	Status        SeatChangeStatus `db:"status"`
+	CreatedAt     time.Time        `db:"created_at"`
+}
+
+// PriceDifferenceCents is positive when the new seat is more expensive.
+func (c *SeatChange) PriceDifferenceCents() int64 {
+	return c.NewPriceCents - c.OldPriceCents
+}
+
+// RefundKey is the key of the refund giving back the seat change's price
+// difference. It is not a booking refund key, its result never moves the
+// booking.
+func (c *SeatChange) RefundKey() string {
+	return "seat-change/" + c.ID
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// Ticket is the e-ticket issued for one passenger once the booking is paid.
+type Ticket struct {
+	ID            int64     `db:"id"`
+	BookingID     string    `db:"booking_id"`
+	BookingItemID string    `db:"booking_item_id"`
+	TicketNumber  string    `db:"ticket_number"`
+	CouponNumber  int       `db:"coupon_number"`
+	IssuedAt      time.Time `db:"issued_at"`
+}
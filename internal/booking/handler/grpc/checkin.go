@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
+	"github.com/squ1ky/flyte/internal/booking/boardingpass"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	boardingPassFormatBCBP = "bcbp"
+	boardingPassFormatPNG  = "png"
+	boardingPassFormatPDF  = "pdf"
+)
+
+func (s *Server) CheckIn(ctx context.Context, req *bookingv1.CheckInRequest) (*bookingv1.CheckInResponse, error) {
+	if err := validateCheckInRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	passes, err := s.svc.CheckIn(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := checkInErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check in: %v", err)
+	}
+
+	return &bookingv1.CheckInResponse{BoardingPasses: mapBoardingPassesToProto(passes)}, nil
+}
+
+func (s *Server) GetBoardingPass(ctx context.Context, req *bookingv1.GetBoardingPassRequest) (*bookingv1.GetBoardingPassResponse, error) {
+	if err := validateGetBoardingPassRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	passes, err := s.svc.GetBoardingPasses(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := checkInErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get boarding pass: %v", err)
+	}
+
+	if seat := strings.TrimSpace(req.SeatNumber); seat != "" {
+		passes = filterPassesBySeat(passes, seat)
+		if len(passes) == 0 {
+			return nil, status.Errorf(codes.NotFound, "no boarding pass for seat %s", seat)
+		}
+	}
+
+	resp := &bookingv1.GetBoardingPassResponse{BoardingPasses: mapBoardingPassesToProto(passes)}
+
+	switch strings.ToLower(req.Format) {
+	case boardingPassFormatPNG:
+		if len(passes) != 1 {
+			return nil, status.Error(codes.InvalidArgument, "seat_number is required for png on a multi-passenger booking")
+		}
+		content, err := boardingpass.RenderPNG(passes[0])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to render boarding pass: %v", err)
+		}
+		resp.ContentType = "image/png"
+		resp.Content = content
+	case boardingPassFormatPDF:
+		content, err := boardingpass.RenderPDF(passes)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to render boarding pass: %v", err)
+		}
+		resp.ContentType = "application/pdf"
+		resp.Content = content
+	}
+
+	return resp, nil
+}
+
+func checkInErrorStatus(err error) error {
+	if st := accessErrorStatus(err); st != nil {
+		return st
+	}
+	switch {
+	case errors.Is(err, domain.ErrNotCheckInable),
+		errors.Is(err, domain.ErrNotCheckedIn),
+		errors.Is(err, domain.ErrCheckInNotOpen),
+		errors.Is(err, domain.ErrCheckInClosed),
+		errors.Is(err, domain.ErrStatusChanged):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrFlightNotFound):
+		return status.Error(codes.NotFound, domain.ErrFlightNotFound.Error())
+	default:
+		return nil
+	}
+}
+
+func filterPassesBySeat(passes []boardingpass.Pass, seat string) []boardingpass.Pass {
+	for _, p := range passes {
+		if strings.EqualFold(p.SeatNumber, seat) {
+			return []boardingpass.Pass{p}
+		}
+	}
+	return nil
+}
+
+func mapBoardingPassesToProto(passes []boardingpass.Pass) []*bookingv1.BoardingPass {
+	out := make([]*bookingv1.BoardingPass, 0, len(passes))
+	for _, p := range passes {
+		out = append(out, &bookingv1.BoardingPass{
+			SeatNumber:     p.SeatNumber,
+			PassengerName:  p.PassengerName,
+			SequenceNumber: int32(p.SequenceNumber),
+			Bcbp:           p.BCBP,
+		})
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/pkg/outbox"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+func (s *Server) ListDeadOutboxEvents(ctx context.Context, req *bookingv1.ListDeadOutboxEventsRequest) (*bookingv1.ListDeadOutboxEventsResponse, error) {
+	if err := validateListDeadOutboxEventsRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	deadEvents, err := s.svc.ListDeadOutboxEvents(ctx, mapCallerFromProto(req.Caller), int(req.Limit))
+	if err != nil {
+		if st := outboxErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list dead outbox events: %v", err)
+	}
+
+	resp := &bookingv1.ListDeadOutboxEventsResponse{
+		Events: make([]*bookingv1.OutboxEvent, 0, len(deadEvents)),
+	}
+	for _, e := range deadEvents {
+		resp.Events = append(resp.Events, mapOutboxEventToProto(e))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ReplayOutboxEvent(ctx context.Context, req *bookingv1.ReplayOutboxEventRequest) (*bookingv1.ReplayOutboxEventResponse, error) {
+	if err := validateReplayOutboxEventRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if err := s.svc.ReplayOutboxEvent(ctx, mapCallerFromProto(req.Caller), strings.TrimSpace(req.EventId)); err != nil {
+		if st := outboxErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to replay outbox event: %v", err)
+	}
+
+	return &bookingv1.ReplayOutboxEventResponse{}, nil
+}
+
+func outboxErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAdminOnly):
+		return status.Error(codes.PermissionDenied, domain.ErrAdminOnly.Error())
+	case errors.Is(err, domain.ErrOutboxEventNotFound):
+		return status.Error(codes.NotFound, "dead outbox event not found")
+	default:
+		return nil
+	}
+}
+
+func mapOutboxEventToProto(e outbox.Event) *bookingv1.OutboxEvent {
+	event := &bookingv1.OutboxEvent{
+		Id:        e.ID,
+		EventType: e.EventType,
+		Payload:   string(e.Payload),
+		Attempts:  int32(e.Attempts),
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+	if e.LastError != nil {
+		event.LastError = *e.LastError
+	}
+	return event
+}
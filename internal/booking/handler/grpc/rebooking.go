@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+func (s *Server) GetRebookingOffer(ctx context.Context, req *bookingv1.GetRebookingOfferRequest) (*bookingv1.GetRebookingOfferResponse, error) {
+	if err := validateGetRebookingOfferRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	offer, err := s.svc.GetRebookingOffer(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := rebookingErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get rebooking offer: %v", err)
+	}
+
+	return &bookingv1.GetRebookingOfferResponse{Offer: mapRebookingOfferToProto(offer)}, nil
+}
+
+func (s *Server) AcceptRebooking(ctx context.Context, req *bookingv1.AcceptRebookingRequest) (*bookingv1.AcceptRebookingResponse, error) {
+	if err := validateAcceptRebookingRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	id, err := s.svc.AcceptRebooking(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := rebookingErrorStatus(err); st != nil {
+			return nil, st
+		}
+		if st := fareErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rebook: %v", err)
+	}
+
+	return &bookingv1.AcceptRebookingResponse{BookingId: id}, nil
+}
+
+func rebookingErrorStatus(err error) error {
+	if st := accessErrorStatus(err); st != nil {
+		return st
+	}
+	switch {
+	case errors.Is(err, domain.ErrRebookingOfferNotFound):
+		return status.Error(codes.NotFound, domain.ErrRebookingOfferNotFound.Error())
+	case errors.Is(err, domain.ErrRebookingOfferTaken),
+		errors.Is(err, domain.ErrNoSeatsForRebooking):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil
+	}
+}
+
+func mapRebookingOfferToProto(o *domain.RebookingOffer) *bookingv1.RebookingOffer {
+	offer := &bookingv1.RebookingOffer{
+		BookingId:     o.BookingID,
+		FlightId:      o.FlightID,
+		DepartureTime: timestamppb.New(o.DepartureTime),
+		Status:        string(o.Status),
+	}
+	if o.NewBookingID != nil {
+		offer.NewBookingId = *o.NewBookingID
+	}
+	return offer
+}
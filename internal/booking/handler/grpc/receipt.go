@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	receiptFormatHTML = "html"
+	receiptFormatPDF  = "pdf"
+)
+
+func (s *Server) GetItineraryReceipt(ctx context.Context, req *bookingv1.GetItineraryReceiptRequest) (*bookingv1.GetItineraryReceiptResponse, error) {
+	if err := validateGetItineraryReceiptRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	r, err := s.svc.GetItineraryReceipt(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, domain.ErrNotTicketed) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get receipt: %v", err)
+	}
+
+	resp := &bookingv1.GetItineraryReceiptResponse{}
+	if strings.ToLower(req.Format) == receiptFormatPDF {
+		resp.ContentType = "application/pdf"
+		resp.Content, err = r.PDF()
+	} else {
+		resp.ContentType = "text/html; charset=utf-8"
+		resp.Content, err = r.HTML()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render receipt: %v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+func (s *Server) ListSagas(ctx context.Context, req *bookingv1.ListSagasRequest) (*bookingv1.ListSagasResponse, error) {
+	if err := validateListSagasRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	filter := domain.SagaFilter{
+		State:     domain.SagaState(strings.ToUpper(strings.TrimSpace(req.State))),
+		BookingID: strings.TrimSpace(req.BookingId),
+		Limit:     int(req.Limit),
+	}
+	sagas, err := s.svc.ListSagas(ctx, mapCallerFromProto(req.Caller), filter)
+	if err != nil {
+		if errors.Is(err, domain.ErrAdminOnly) {
+			return nil, status.Error(codes.PermissionDenied, domain.ErrAdminOnly.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list sagas: %v", err)
+	}
+
+	resp := &bookingv1.ListSagasResponse{
+		Sagas: make([]*bookingv1.Saga, 0, len(sagas)),
+	}
+	for _, saga := range sagas {
+		resp.Sagas = append(resp.Sagas, mapSagaToProto(saga))
+	}
+
+	return resp, nil
+}
+
+func mapSagaToProto(s domain.Saga) *bookingv1.Saga {
+	saga := &bookingv1.Saga{
+		Id:            s.ID,
+		UserId:        s.UserID,
+		FlightId:      s.FlightID,
+		State:         string(s.State),
+		ReservedSeats: s.ReservedSeats,
+		Attempts:      int32(s.Attempts),
+		NextAttemptAt: timestamppb.New(s.NextAttemptAt),
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+		UpdatedAt:     timestamppb.New(s.UpdatedAt),
+	}
+	if s.BookingID != nil {
+		saga.BookingId = *s.BookingID
+	}
+	if s.FailureReason != nil {
+		saga.FailureReason = *s.FailureReason
+	}
+	if s.LastError != nil {
+		saga.LastError = *s.LastError
+	}
+	return saga
+}
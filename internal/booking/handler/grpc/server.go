@@ -38,16 +38,19 @@ func (s *Server) CreateBooking(ctx context.Context, req *bookingv1.CreateBooking
 	}
 
 	dto := service.CreateBookingDTO{
-		UserID:            req.UserId,
-		FlightID:          req.FlightId,
-		SeatNumber:        strings.TrimSpace(req.SeatNumber),
-		PriceCents:        req.PriceCents,
-		Currency:          strings.TrimSpace(req.Currency),
-		PassengerName:     strings.TrimSpace(req.PassengerName),
-		PassengerPassport: strings.TrimSpace(req.PassengerPassport),
+		UserID:           req.UserId,
+		FlightID:         req.FlightId,
+		QuotedPriceCents: req.QuotedPriceCents,
+		Items:            make([]service.CreateBookingItemDTO, 0, len(req.Items)),
+		IdempotencyKey:   strings.TrimSpace(req.IdempotencyKey),
 	}
-	if dto.Currency == "" {
-		dto.Currency = "RUB"
+	for _, item := range req.Items {
+		dto.Items = append(dto.Items, service.CreateBookingItemDTO{
+			SeatNumber:        strings.TrimSpace(item.SeatNumber),
+			PassengerID:       item.PassengerId,
+			PassengerName:     strings.TrimSpace(item.PassengerName),
+			PassengerPassport: strings.TrimSpace(item.PassengerPassport),
+		})
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -55,12 +58,55 @@ func (s *Server) CreateBooking(ctx context.Context, req *bookingv1.CreateBooking
 
 	id, err := s.svc.CreateBooking(ctx, dto)
 	if err != nil {
+		if errors.Is(err, domain.ErrIdempotencyKeyReused) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if st := fareErrorStatus(err); st != nil {
+			return nil, st
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create booking: %v", err)
 	}
 
 	return &bookingv1.CreateBookingResponse{BookingId: id}, nil
 }
 
+func (s *Server) GetBookingQuote(ctx context.Context, req *bookingv1.GetBookingQuoteRequest) (*bookingv1.GetBookingQuoteResponse, error) {
+	if err := validateGetBookingQuoteRequest(req); err != nil {
+		return nil, err
+	}
+
+	seatNumbers := make([]string, 0, len(req.SeatNumbers))
+	for _, seat := range req.SeatNumbers {
+		seatNumbers = append(seatNumbers, strings.TrimSpace(seat))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	quote, err := s.svc.QuoteBooking(ctx, req.FlightId, seatNumbers)
+	if err != nil {
+		if st := fareErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to quote booking: %v", err)
+	}
+
+	items := make([]*bookingv1.QuoteItem, 0, len(quote.Items))
+	for _, item := range quote.Items {
+		items = append(items, &bookingv1.QuoteItem{
+			SeatNumber: item.SeatNumber,
+			PriceCents: item.PriceCents,
+		})
+	}
+
+	return &bookingv1.GetBookingQuoteResponse{
+		FlightId:        quote.FlightID,
+		Items:           items,
+		TotalPriceCents: quote.TotalCents,
+		Currency:        quote.Currency,
+	}, nil
+}
+
 func (s *Server) GetBooking(ctx context.Context, req *bookingv1.GetBookingRequest) (*bookingv1.GetBookingResponse, error) {
 	if err := validateGetBookingRequest(req); err != nil {
 		return nil, err
@@ -69,10 +115,10 @@ func (s *Server) GetBooking(ctx context.Context, req *bookingv1.GetBookingReques
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	b, err := s.svc.GetBooking(ctx, strings.TrimSpace(req.BookingId))
+	b, err := s.svc.GetBooking(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
 	if err != nil {
-		if errors.Is(err, domain.ErrBookingNotFound) {
-			return nil, status.Error(codes.NotFound, "booking not found")
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get booking: %v", err)
 	}
@@ -80,6 +126,61 @@ func (s *Server) GetBooking(ctx context.Context, req *bookingv1.GetBookingReques
 	return &bookingv1.GetBookingResponse{Booking: mapBookingToProto(b)}, nil
 }
 
+func (s *Server) LookupBooking(ctx context.Context, req *bookingv1.LookupBookingRequest) (*bookingv1.LookupBookingResponse, error) {
+	if err := validateLookupBookingRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	b, err := s.svc.LookupBooking(ctx, strings.TrimSpace(req.Pnr), strings.TrimSpace(req.LastName))
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			return nil, status.Error(codes.NotFound, domain.ErrBookingNotFound.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to lookup booking: %v", err)
+	}
+
+	return &bookingv1.LookupBookingResponse{Booking: mapBookingToProto(b)}, nil
+}
+
+func (s *Server) GetBookingHistory(ctx context.Context, req *bookingv1.GetBookingHistoryRequest) (*bookingv1.GetBookingHistoryResponse, error) {
+	if err := validateGetBookingHistoryRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	history, err := s.svc.GetBookingHistory(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get booking history: %v", err)
+	}
+
+	out := make([]*bookingv1.BookingStatusChange, 0, len(history))
+	for _, entry := range history {
+		change := &bookingv1.BookingStatusChange{
+			ToStatus:  string(entry.ToStatus),
+			Actor:     string(entry.Actor),
+			Reason:    entry.Reason,
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+		}
+		if entry.FromStatus != nil {
+			change.FromStatus = string(*entry.FromStatus)
+		}
+		if entry.ActorID != nil {
+			change.ActorId = *entry.ActorID
+		}
+		out = append(out, change)
+	}
+
+	return &bookingv1.GetBookingHistoryResponse{History: out}, nil
+}
+
 func (s *Server) ListBookings(ctx context.Context, req *bookingv1.ListBookingsRequest) (*bookingv1.ListBookingsResponse, error) {
 	if err := validateListBookingsRequest(req); err != nil {
 		return nil, err
@@ -88,7 +189,12 @@ func (s *Server) ListBookings(ctx context.Context, req *bookingv1.ListBookingsRe
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	list, err := s.svc.ListBookings(ctx, req.UserId)
+	filter, err := mapListFilterFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, next, err := s.svc.ListBookings(ctx, filter)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list bookings: %v", err)
 	}
@@ -98,7 +204,12 @@ func (s *Server) ListBookings(ctx context.Context, req *bookingv1.ListBookingsRe
 		out = append(out, mapBookingToProto(&list[i]))
 	}
 
-	return &bookingv1.ListBookingsResponse{Bookings: out}, nil
+	resp := &bookingv1.ListBookingsResponse{Bookings: out}
+	if next != nil {
+		resp.NextCursor = next.Encode()
+	}
+
+	return resp, nil
 }
 
 func (s *Server) CancelBooking(ctx context.Context, req *bookingv1.CancelBookingRequest) (*bookingv1.CancelBookingResponse, error) {
@@ -109,28 +220,162 @@ func (s *Server) CancelBooking(ctx context.Context, req *bookingv1.CancelBooking
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	if err := s.svc.CancelBooking(ctx, req.BookingId); err != nil {
+	if err := s.svc.CancelBooking(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller)); err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, domain.ErrNotCancellable) || errors.Is(err, domain.ErrStatusChanged) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to cancel booking: %v", err)
 	}
 
 	return &bookingv1.CancelBookingResponse{}, nil
 }
 
+func (s *Server) ChangeSeat(ctx context.Context, req *bookingv1.ChangeSeatRequest) (*bookingv1.ChangeSeatResponse, error) {
+	if err := validateChangeSeatRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	b, seatChange, err := s.svc.ChangeSeat(ctx, service.ChangeSeatDTO{
+		BookingID:     strings.TrimSpace(req.BookingId),
+		Caller:        mapCallerFromProto(req.Caller),
+		OldSeatNumber: strings.TrimSpace(req.OldSeatNumber),
+		NewSeatNumber: strings.TrimSpace(req.NewSeatNumber),
+	})
+	if err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		if st := fareErrorStatus(err); st != nil {
+			return nil, st
+		}
+		switch {
+		case errors.Is(err, domain.ErrSeatNotInBooking):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrSeatNotChangeable), errors.Is(err, domain.ErrSeatChangePending),
+			errors.Is(err, domain.ErrStatusChanged):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to change seat: %v", err)
+	}
+
+	return &bookingv1.ChangeSeatResponse{
+		Booking:              mapBookingToProto(b),
+		PriceDifferenceCents: seatChange.PriceDifferenceCents(),
+		SeatChangeStatus:     string(seatChange.Status),
+	}, nil
+}
+
+func accessErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBookingNotFound):
+		return status.Error(codes.NotFound, domain.ErrBookingNotFound.Error())
+	case errors.Is(err, domain.ErrBookingAccessDenied):
+		return status.Error(codes.PermissionDenied, domain.ErrBookingAccessDenied.Error())
+	default:
+		return nil
+	}
+}
+
+func fareErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrFlightNotFound):
+		return status.Error(codes.NotFound, domain.ErrFlightNotFound.Error())
+	case errors.Is(err, domain.ErrSeatNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrSeatAlreadyBooked):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrPriceChanged), errors.Is(err, domain.ErrFlightCancelled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrPassengerNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return nil
+	}
+}
+
+func mapListFilterFromProto(req *bookingv1.ListBookingsRequest) (domain.BookingFilter, error) {
+	filter := domain.BookingFilter{
+		UserID:      req.UserId,
+		FlightID:    req.FlightId,
+		Limit:       int(req.PageSize),
+		OldestFirst: strings.EqualFold(req.SortOrder, sortOrderAsc),
+	}
+
+	for _, st := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, domain.BookingStatus(strings.ToUpper(strings.TrimSpace(st))))
+	}
+	if req.DepartureFrom != nil {
+		from := req.DepartureFrom.AsTime()
+		filter.DepartureFrom = &from
+	}
+	if req.DepartureTo != nil {
+		to := req.DepartureTo.AsTime()
+		filter.DepartureTo = &to
+	}
+
+	if req.Cursor != "" {
+		cursor, err := domain.DecodeBookingCursor(req.Cursor)
+		if err != nil {
+			return domain.BookingFilter{}, err
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
+}
+
+func mapCallerFromProto(c *bookingv1.Caller) domain.Caller {
+	return domain.Caller{
+		UserID: c.GetUserId(),
+		Role:   c.GetRole(),
+	}
+}
+
 func mapBookingToProto(b *domain.Booking) *bookingv1.Booking {
 	if b == nil {
 		return nil
 	}
-	return &bookingv1.Booking{
-		Id:                b.ID,
-		UserId:            b.UserID,
-		FlightId:          b.FlightID,
-		SeatNumber:        b.SeatNumber,
-		PassengerName:     b.PassengerName,
-		PassengerPassport: b.PassengerPassport,
-		Status:            string(b.Status),
-		PriceCents:        b.PriceCents,
-		Currency:          b.Currency,
-		CreatedAt:         timestamppb.New(b.CreatedAt),
-		UpdatedAt:         timestamppb.New(b.UpdatedAt),
+
+	items := make([]*bookingv1.BookingItem, 0, len(b.Items))
+	for _, item := range b.Items {
+		protoItem := &bookingv1.BookingItem{
+			SeatNumber:        item.SeatNumber,
+			PassengerName:     item.PassengerName,
+			PassengerPassport: item.PassengerPassport,
+			PriceCents:        item.PriceCents,
+			DocumentType:      item.DocumentType,
+			Citizenship:       item.Citizenship,
+		}
+		if item.PassengerID != nil {
+			protoItem.PassengerId = *item.PassengerID
+		}
+		if item.TicketNumber != nil {
+			protoItem.TicketNumber = *item.TicketNumber
+		}
+		items = append(items, protoItem)
+	}
+
+	out := &bookingv1.Booking{
+		Id:         b.ID,
+		Pnr:        b.PNR,
+		UserId:     b.UserID,
+		FlightId:   b.FlightID,
+		Status:     string(b.Status),
+		PriceCents: b.PriceCents,
+		Currency:   b.Currency,
+		CreatedAt:  timestamppb.New(b.CreatedAt),
+		UpdatedAt:  timestamppb.New(b.UpdatedAt),
+		Items:      items,
+	}
+	if b.DepartureTime != nil {
+		out.DepartureTime = timestamppb.New(*b.DepartureTime)
 	}
+
+	return out
 }
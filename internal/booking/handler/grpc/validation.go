@@ -2,11 +2,20 @@ package grpc
 
 import (
 	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
+	"github.com/squ1ky/flyte/internal/booking/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"strings"
 )
 
+const (
+	maxBookingItems      = 9
+	maxIdempotencyKeyLen = 255
+
+	sortOrderAsc  = "asc"
+	sortOrderDesc = "desc"
+)
+
 func validateCreateBookingRequest(req *bookingv1.CreateBookingRequest) error {
 	if req == nil {
 		return status.Error(codes.InvalidArgument, "request is nil")
@@ -17,17 +26,62 @@ func validateCreateBookingRequest(req *bookingv1.CreateBookingRequest) error {
 	if req.FlightId <= 0 {
 		return status.Error(codes.InvalidArgument, "flight_id must be > 0")
 	}
-	if strings.TrimSpace(req.SeatNumber) == "" {
-		return status.Error(codes.InvalidArgument, "seat_number is required")
+	if req.QuotedPriceCents <= 0 {
+		return status.Error(codes.InvalidArgument, "quoted_price_cents must be > 0")
+	}
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLen {
+		return status.Errorf(codes.InvalidArgument, "idempotency_key must be at most %d characters", maxIdempotencyKeyLen)
 	}
-	if strings.TrimSpace(req.PassengerName) == "" {
-		return status.Error(codes.InvalidArgument, "passenger_name is required")
+
+	seats := make([]string, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item == nil {
+			return status.Error(codes.InvalidArgument, "booking item is nil")
+		}
+		if item.PassengerId < 0 {
+			return status.Error(codes.InvalidArgument, "passenger_id must be > 0")
+		}
+		if item.PassengerId == 0 {
+			if strings.TrimSpace(item.PassengerName) == "" {
+				return status.Error(codes.InvalidArgument, "passenger_name is required")
+			}
+			if strings.TrimSpace(item.PassengerPassport) == "" {
+				return status.Error(codes.InvalidArgument, "passenger_passport is required")
+			}
+		}
+		seats = append(seats, item.SeatNumber)
 	}
-	if strings.TrimSpace(req.PassengerPassport) == "" {
-		return status.Error(codes.InvalidArgument, "passenger_passport is required")
+	return validateSeatNumbers(seats)
+}
+
+func validateGetBookingQuoteRequest(req *bookingv1.GetBookingQuoteRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.FlightId <= 0 {
+		return status.Error(codes.InvalidArgument, "flight_id must be > 0")
+	}
+	return validateSeatNumbers(req.SeatNumbers)
+}
+
+func validateSeatNumbers(seatNumbers []string) error {
+	if len(seatNumbers) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one seat is required")
 	}
-	if req.PriceCents <= 0 {
-		return status.Error(codes.InvalidArgument, "price must be > 0")
+	if len(seatNumbers) > maxBookingItems {
+		return status.Errorf(codes.InvalidArgument, "no more than %d passengers per booking", maxBookingItems)
+	}
+
+	seen := make(map[string]struct{}, len(seatNumbers))
+	for _, seat := range seatNumbers {
+		seat = strings.TrimSpace(seat)
+		if seat == "" {
+			return status.Error(codes.InvalidArgument, "seat_number is required")
+		}
+		if _, ok := seen[seat]; ok {
+			return status.Errorf(codes.InvalidArgument, "seat %s is requested more than once", seat)
+		}
+		seen[seat] = struct{}{}
 	}
 	return nil
 }
@@ -39,7 +93,7 @@ func validateGetBookingRequest(req *bookingv1.GetBookingRequest) error {
 	if strings.TrimSpace(req.BookingId) == "" {
 		return status.Error(codes.InvalidArgument, "booking_id is required")
 	}
-	return nil
+	return validateCaller(req.Caller)
 }
 
 func validateListBookingsRequest(req *bookingv1.ListBookingsRequest) error {
@@ -49,6 +103,26 @@ func validateListBookingsRequest(req *bookingv1.ListBookingsRequest) error {
 	if req.UserId <= 0 {
 		return status.Error(codes.InvalidArgument, "user_id must be > 0")
 	}
+	if req.PageSize < 0 {
+		return status.Error(codes.InvalidArgument, "page_size must be >= 0")
+	}
+	if req.FlightId < 0 {
+		return status.Error(codes.InvalidArgument, "flight_id must be > 0")
+	}
+	switch strings.ToLower(req.SortOrder) {
+	case "", sortOrderAsc, sortOrderDesc:
+	default:
+		return status.Errorf(codes.InvalidArgument, "sort_order must be %q or %q", sortOrderAsc, sortOrderDesc)
+	}
+	for _, st := range req.Statuses {
+		if !domain.BookingStatus(strings.ToUpper(strings.TrimSpace(st))).IsValid() {
+			return status.Errorf(codes.InvalidArgument, "unknown status %q", st)
+		}
+	}
+	if req.DepartureFrom != nil && req.DepartureTo != nil &&
+		!req.DepartureFrom.AsTime().Before(req.DepartureTo.AsTime()) {
+		return status.Error(codes.InvalidArgument, "departure_from must be before departure_to")
+	}
 	return nil
 }
 
@@ -59,5 +133,156 @@ func validateCancelBookingRequest(req *bookingv1.CancelBookingRequest) error {
 	if strings.TrimSpace(req.BookingId) == "" {
 		return status.Error(codes.InvalidArgument, "booking_id is required")
 	}
+	return validateCaller(req.Caller)
+}
+
+func validateGetBookingHistoryRequest(req *bookingv1.GetBookingHistoryRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateChangeSeatRequest(req *bookingv1.ChangeSeatRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	oldSeat := strings.TrimSpace(req.OldSeatNumber)
+	newSeat := strings.TrimSpace(req.NewSeatNumber)
+	if oldSeat == "" || newSeat == "" {
+		return status.Error(codes.InvalidArgument, "old_seat_number and new_seat_number are required")
+	}
+	if oldSeat == newSeat {
+		return status.Error(codes.InvalidArgument, "new seat must differ from the old one")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateLookupBookingRequest(req *bookingv1.LookupBookingRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	pnr := strings.TrimSpace(req.Pnr)
+	if len(pnr) != domain.PNRLength {
+		return status.Errorf(codes.InvalidArgument, "pnr must be %d characters", domain.PNRLength)
+	}
+	for _, r := range pnr {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9') {
+			return status.Error(codes.InvalidArgument, "pnr must be alphanumeric")
+		}
+	}
+	if strings.TrimSpace(req.LastName) == "" {
+		return status.Error(codes.InvalidArgument, "last_name is required")
+	}
+	return nil
+}
+
+func validateCheckInRequest(req *bookingv1.CheckInRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateGetBoardingPassRequest(req *bookingv1.GetBoardingPassRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	switch strings.ToLower(req.Format) {
+	case "", boardingPassFormatBCBP, boardingPassFormatPNG, boardingPassFormatPDF:
+	default:
+		return status.Errorf(codes.InvalidArgument, "format must be one of %s, %s, %s",
+			boardingPassFormatBCBP, boardingPassFormatPNG, boardingPassFormatPDF)
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateGetItineraryReceiptRequest(req *bookingv1.GetItineraryReceiptRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	switch strings.ToLower(req.Format) {
+	case "", receiptFormatHTML, receiptFormatPDF:
+	default:
+		return status.Errorf(codes.InvalidArgument, "format must be %s or %s", receiptFormatHTML, receiptFormatPDF)
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateGetRebookingOfferRequest(req *bookingv1.GetRebookingOfferRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateAcceptRebookingRequest(req *bookingv1.AcceptRebookingRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateListDeadOutboxEventsRequest(req *bookingv1.ListDeadOutboxEventsRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Limit < 0 {
+		return status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateReplayOutboxEventRequest(req *bookingv1.ReplayOutboxEventRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.EventId) == "" {
+		return status.Error(codes.InvalidArgument, "event_id is required")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateListSagasRequest(req *bookingv1.ListSagasRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Limit < 0 {
+		return status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+	if req.State != "" && !domain.SagaState(strings.ToUpper(strings.TrimSpace(req.State))).IsValid() {
+		return status.Errorf(codes.InvalidArgument, "unknown state %q", req.State)
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateCaller(caller *bookingv1.Caller) error {
+	if caller == nil {
+		return status.Error(codes.InvalidArgument, "caller is required")
+	}
+	if caller.UserId <= 0 {
+		return status.Error(codes.InvalidArgument, "caller.user_id must be > 0")
+	}
 	return nil
 }
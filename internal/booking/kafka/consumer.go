@@ -2,19 +2,22 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/segmentio/kafka-go"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
 	"github.com/squ1ky/flyte/internal/booking/config"
 	"github.com/squ1ky/flyte/internal/booking/domain/events"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"github.com/squ1ky/flyte/pkg/kafkaretry"
 	"log/slog"
 	"time"
 )
 
 type PaymentResultConsumer struct {
-	reader  *kafka.Reader
-	handler MessageHandler
-	log     *slog.Logger
+	consumer *kafkaretry.Consumer
+	handler  MessageHandler
+	log      *slog.Logger
 }
 
 func NewPaymentResultConsumer(
@@ -22,71 +25,77 @@ func NewPaymentResultConsumer(
 	handler MessageHandler,
 	log *slog.Logger,
 ) *PaymentResultConsumer {
-	reader := kafka.NewReader(kafka.ReaderConfig{
-		Brokers:  cfg.Brokers,
-		Topic:    cfg.TopicResults,
-		GroupID:  cfg.GroupID,
-		MinBytes: 10e3,
-		MaxBytes: 10e6,
-	})
-
-	return &PaymentResultConsumer{
-		reader:  reader,
+	c := &PaymentResultConsumer{
 		handler: handler,
 		log:     log,
 	}
+	c.consumer = kafkaretry.NewConsumer(kafkaretry.Config{
+		Brokers: cfg.Brokers,
+		Topic:   cfg.TopicResults,
+		GroupID: cfg.GroupID,
+		Delays:  cfg.RetryDelays,
+	}, inbox.Wrap(cfg.GroupID, c.processMessage, log), log)
+
+	return c
 }
 
 func (c *PaymentResultConsumer) Start(ctx context.Context) error {
-	c.log.Info("starting kafka consumer", "topic", c.reader.Config().Topic)
-
-	for {
-		select {
-		case <-ctx.Done():
-			c.log.Info("stopping kafka consumer")
-			return ctx.Err()
-		default:
-		}
-
-		m, err := c.reader.FetchMessage(ctx)
-		if err != nil {
-			c.log.Error("failed to fetch message", "error", err)
-			time.Sleep(time.Second)
-			continue
-		}
-
-		if err := c.processMessage(ctx, m); err != nil {
-			c.log.Error("failed to process message",
-				"error", err,
-				"offset", m.Offset)
-			continue
-		}
-
-		if err := c.reader.CommitMessages(ctx, m); err != nil {
-			c.log.Error("failed to commit message",
-				"error", err,
-				"offset", m.Offset)
-		}
-	}
+	return c.consumer.Start(ctx)
 }
 
 func (c *PaymentResultConsumer) processMessage(ctx context.Context, m kafka.Message) error {
-	var res events.PaymentResultEvent
-	if err := json.Unmarshal(m.Value, &res); err != nil {
-		return fmt.Errorf("failed to unmarshal result: %w", err)
+	var msg eventsv1.PaymentResult
+	if err := eventcodec.Unmarshal(m, &msg); err != nil {
+		return kafkaretry.Permanent(fmt.Errorf("failed to decode result: %w", err))
 	}
+	res := mapPaymentResult(&msg)
 
+	eventType := headerValue(m, eventcodec.HeaderEventType)
 	c.log.Info("received payment result",
+		"event_type", eventType,
 		"booking_id", res.BookingID,
 		"status", res.Status,
 		"offset", m.Offset)
 
-	return c.handler.HandlePaymentResult(ctx, res)
+	switch eventType {
+	case events.EventTypeRefundResult:
+		return c.handler.HandleRefundResult(ctx, res)
+	case events.EventTypePaymentCaptureResult:
+		return c.handler.HandleCaptureResult(ctx, res)
+	case events.EventTypePaymentVoidResult:
+		return c.handler.HandleVoidResult(ctx, res)
+	case events.EventTypePaymentResult, "":
+		return c.handler.HandlePaymentResult(ctx, res)
+	default:
+		return kafkaretry.Permanent(fmt.Errorf("unknown event type %q", eventType))
+	}
 }
 
-func (c *PaymentResultConsumer) Close() error {
-	if c.reader != nil {
-		return c.reader.Close()
+func mapPaymentResult(msg *eventsv1.PaymentResult) events.PaymentResultEvent {
+	res := events.PaymentResultEvent{
+		BookingID:    msg.BookingId,
+		PaymentID:    msg.PaymentId,
+		Reference:    msg.Reference,
+		Status:       events.PaymentStatus(msg.Status),
+		ErrorMessage: msg.ErrorMessage,
+		RefundKey:    msg.RefundKey,
+		AmountCents:  msg.AmountCents,
+	}
+	if msg.ProcessedAt != nil {
+		res.ProcessedAt = msg.ProcessedAt.AsTime().Format(time.RFC3339Nano)
+	}
+	return res
+}
+
+func headerValue(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
 	}
-	return nil
+	return ""
+}
+
+func (c *PaymentResultConsumer) Close() error {
+	return c.consumer.Close()
 }
@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/internal/booking/config"
+	"github.com/squ1ky/flyte/internal/booking/domain/events"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"github.com/squ1ky/flyte/pkg/kafkaretry"
+	"log/slog"
+)
+
+type FlightEventProcessor interface {
+	ProcessFlightCancelled(ctx context.Context, ev events.FlightCancelledEvent) error
+}
+
+// FlightEventConsumer reads the flight service's events, only cancellations
+// are of interest to bookings.
+type FlightEventConsumer struct {
+	consumer  *kafkaretry.Consumer
+	processor FlightEventProcessor
+	log       *slog.Logger
+}
+
+func NewFlightEventConsumer(
+	cfg config.KafkaConfig,
+	processor FlightEventProcessor,
+	log *slog.Logger,
+) *FlightEventConsumer {
+	c := &FlightEventConsumer{
+		processor: processor,
+		log:       log,
+	}
+	c.consumer = kafkaretry.NewConsumer(kafkaretry.Config{
+		Brokers: cfg.Brokers,
+		Topic:   cfg.TopicFlightEvents,
+		GroupID: cfg.GroupID,
+		Delays:  cfg.RetryDelays,
+	}, inbox.Wrap(cfg.GroupID, c.processMessage, log), log)
+
+	return c
+}
+
+func (c *FlightEventConsumer) Start(ctx context.Context) error {
+	return c.consumer.Start(ctx)
+}
+
+func (c *FlightEventConsumer) processMessage(ctx context.Context, m kafka.Message) error {
+	eventType := headerValue(m, eventcodec.HeaderEventType)
+
+	switch eventType {
+	case events.EventTypeFlightCancelled:
+		var msg eventsv1.FlightCancelled
+		if err := eventcodec.Unmarshal(m, &msg); err != nil {
+			return kafkaretry.Permanent(fmt.Errorf("failed to decode flight cancelled event: %w", err))
+		}
+		ev := mapFlightCancelled(&msg)
+
+		c.log.Info("received flight cancelled event",
+			"flight_id", ev.FlightID,
+			"offset", m.Offset)
+
+		if err := c.processor.ProcessFlightCancelled(ctx, ev); err != nil {
+			return fmt.Errorf("failed to process flight cancelled event: %w", err)
+		}
+		return nil
+	default:
+		c.log.Debug("skipping flight event", "event_type", eventType, "offset", m.Offset)
+		return nil
+	}
+}
+
+func mapFlightCancelled(msg *eventsv1.FlightCancelled) events.FlightCancelledEvent {
+	return events.FlightCancelledEvent{
+		FlightID:         msg.FlightId,
+		FlightNumber:     msg.FlightNumber,
+		DepartureAirport: msg.DepartureAirport,
+		ArrivalAirport:   msg.ArrivalAirport,
+		DepartureTime:    msg.DepartureTime.AsTime(),
+		Reason:           msg.Reason,
+		CancelledAt:      msg.CancelledAt.AsTime(),
+	}
+}
+
+func (c *FlightEventConsumer) Close() error {
+	return c.consumer.Close()
+}
@@ -8,11 +8,17 @@ import (
 )
 
 type PaymentResultProcessor interface {
-	ProcessPaymentResult(ctx context.Context, bookingID string, status events.PaymentStatus) error
+	ProcessPaymentResult(ctx context.Context, res events.PaymentResultEvent) error
+	ProcessRefundResult(ctx context.Context, res events.PaymentResultEvent) error
+	ProcessCaptureResult(ctx context.Context, res events.PaymentResultEvent) error
+	ProcessVoidResult(ctx context.Context, res events.PaymentResultEvent) error
 }
 
 type MessageHandler interface {
 	HandlePaymentResult(ctx context.Context, res events.PaymentResultEvent) error
+	HandleRefundResult(ctx context.Context, res events.PaymentResultEvent) error
+	HandleCaptureResult(ctx context.Context, res events.PaymentResultEvent) error
+	HandleVoidResult(ctx context.Context, res events.PaymentResultEvent) error
 }
 
 type PaymentResultHandler struct {
@@ -28,10 +34,37 @@ func NewPaymentResultHandler(service PaymentResultProcessor, log *slog.Logger) *
 }
 
 func (h *PaymentResultHandler) HandlePaymentResult(ctx context.Context, res events.PaymentResultEvent) error {
-	err := h.service.ProcessPaymentResult(ctx, res.BookingID, res.Status)
+	err := h.service.ProcessPaymentResult(ctx, res)
 	if err != nil {
 		return fmt.Errorf("failed to process payment result: %w", err)
 	}
 
 	return nil
 }
+
+func (h *PaymentResultHandler) HandleRefundResult(ctx context.Context, res events.PaymentResultEvent) error {
+	err := h.service.ProcessRefundResult(ctx, res)
+	if err != nil {
+		return fmt.Errorf("failed to process refund result: %w", err)
+	}
+
+	return nil
+}
+
+func (h *PaymentResultHandler) HandleCaptureResult(ctx context.Context, res events.PaymentResultEvent) error {
+	err := h.service.ProcessCaptureResult(ctx, res)
+	if err != nil {
+		return fmt.Errorf("failed to process capture result: %w", err)
+	}
+
+	return nil
+}
+
+func (h *PaymentResultHandler) HandleVoidResult(ctx context.Context, res events.PaymentResultEvent) error {
+	err := h.service.ProcessVoidResult(ctx, res)
+	if err != nil {
+		return fmt.Errorf("failed to process void result: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/squ1ky/flyte/internal/booking/boardingpass"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"html/template"
+	"time"
+)
+
+// Receipt is the itinerary receipt of a ticketed booking.
+type Receipt struct {
+	Booking  *domain.Booking
+	Flight   *domain.Flight
+	IssuedAt time.Time
+}
+
+type line struct {
+	Passenger    string
+	Document     string
+	Seat         string
+	TicketNumber string
+	Fare         string
+}
+
+func (r Receipt) lines() []line {
+	lines := make([]line, 0, len(r.Booking.Items))
+	for _, item := range r.Booking.Items {
+		l := line{
+			Passenger: item.PassengerName,
+			Document:  item.PassengerPassport,
+			Seat:      item.SeatNumber,
+			Fare:      formatMoney(item.PriceCents, r.Booking.Currency),
+		}
+		if item.TicketNumber != nil {
+			l.TicketNumber = *item.TicketNumber
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+var htmlTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Itinerary receipt {{.PNR}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #ccc; padding: 6px; text-align: left; }
+</style>
+</head>
+<body>
+<h1>Itinerary receipt</h1>
+<p>Booking reference: <b>{{.PNR}}</b><br>Issued: {{.IssuedAt}}</p>
+<h2>Flight</h2>
+<p>{{.FlightNumber}}: {{.From}} &rarr; {{.To}}<br>Departure: {{.Departure}}<br>Arrival: {{.Arrival}}</p>
+<h2>Passengers</h2>
+<table>
+<tr><th>Passenger</th><th>Document</th><th>Seat</th><th>E-ticket</th><th>Fare</th></tr>
+{{range .Lines}}<tr><td>{{.Passenger}}</td><td>{{.Document}}</td><td>{{.Seat}}</td><td>{{.TicketNumber}}</td><td>{{.Fare}}</td></tr>
+{{end}}</table>
+<p>Total paid: <b>{{.Total}}</b></p>
+</body>
+</html>
+`))
+
+const timeLayout = "02 Jan 2006 15:04 MST"
+
+func (r Receipt) HTML() ([]byte, error) {
+	data := struct {
+		PNR          string
+		IssuedAt     string
+		FlightNumber string
+		From         string
+		To           string
+		Departure    string
+		Arrival      string
+		Lines        []line
+		Total        string
+	}{
+		PNR:          r.Booking.PNR,
+		IssuedAt:     r.IssuedAt.UTC().Format(timeLayout),
+		FlightNumber: r.Flight.FlightNumber,
+		From:         r.Flight.DepartureAirport,
+		To:           r.Flight.ArrivalAirport,
+		Departure:    r.Flight.DepartureTime.UTC().Format(timeLayout),
+		Arrival:      r.Flight.ArrivalTime.UTC().Format(timeLayout),
+		Lines:        r.lines(),
+		Total:        formatMoney(r.Booking.PriceCents, r.Booking.Currency),
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render receipt: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF renders the receipt on an A4 page. The core PDF fonts only cover
+// Latin-1, so passenger names are transliterated.
+func (r Receipt) PDF() ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Itinerary receipt", "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(0, 6, "Booking reference: "+r.Booking.PNR, "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, "Issued: "+r.IssuedAt.UTC().Format(timeLayout), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 7, "Flight", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(0, 6, fmt.Sprintf("%s: %s -> %s", r.Flight.FlightNumber, r.Flight.DepartureAirport, r.Flight.ArrivalAirport), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, "Departure: "+r.Flight.DepartureTime.UTC().Format(timeLayout), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, "Arrival: "+r.Flight.ArrivalTime.UTC().Format(timeLayout), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	widths := []float64{55, 35, 15, 40, 35}
+	pdf.SetFont("Helvetica", "B", 10)
+	for i, h := range []string{"Passenger", "Document", "Seat", "E-ticket", "Fare"} {
+		pdf.CellFormat(widths[i], 7, h, "1", 0, "L", false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, l := range r.lines() {
+		cells := []string{boardingpass.Transliterate(l.Passenger), boardingpass.Transliterate(l.Document), l.Seat, l.TicketNumber, l.Fare}
+		for i, c := range cells {
+			pdf.CellFormat(widths[i], 7, c, "1", 0, "L", false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(0, 7, "Total paid: "+formatMoney(r.Booking.PriceCents, r.Booking.Currency), "", 1, "L", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render receipt pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func formatMoney(cents int64, currency string) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d %s", sign, cents/100, cents%100, currency)
+}
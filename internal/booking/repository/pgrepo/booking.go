@@ -3,25 +3,49 @@ package pgrepo
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
 	"github.com/squ1ky/flyte/internal/booking/domain"
-	"github.com/squ1ky/flyte/internal/booking/domain/events"
 	"github.com/squ1ky/flyte/internal/booking/repository"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"github.com/squ1ky/flyte/pkg/outbox"
+	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	pgErrUniqueViolation = "23505"
+)
+
+// OutboxTable holds the events the outbox relay publishes.
+const OutboxTable = "booking_outbox"
+
+// InboxTable holds the IDs of the consumed messages that changed a booking.
+const InboxTable = "booking_inbox"
+
 type BookingRepo struct {
-	db *sqlx.DB
+	db           *sqlx.DB
+	outbox       *outbox.Writer
+	outboxEvents *outbox.Store
+	inbox        *inbox.Inbox
 }
 
 func NewBookingRepo(db *sqlx.DB) *BookingRepo {
-	return &BookingRepo{db: db}
+	return &BookingRepo{
+		db:           db,
+		outbox:       outbox.NewWriter(OutboxTable),
+		outboxEvents: outbox.NewStore(db, OutboxTable),
+		inbox:        inbox.New(InboxTable),
+	}
 }
 
-func (r *BookingRepo) Create(ctx context.Context, b *domain.Booking) (string, error) {
+// Create stores a booking whose seats the saga sagaID has reserved and queues
+// its payment request.
+func (r *BookingRepo) Create(ctx context.Context, b *domain.Booking, sagaID string) (string, error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to begin tx: %w", err)
@@ -30,41 +54,67 @@ func (r *BookingRepo) Create(ctx context.Context, b *domain.Booking) (string, er
 
 	queryBooking := `
 		INSERT INTO bookings (
-			user_id, flight_id, seat_number,
-		    passenger_name, passenger_passport,
-		    price_cents, currency, status, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+			pnr, user_id, flight_id, price_cents, currency, status, idempotency_key, request_hash,
+			departure_time, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
 		RETURNING id
 	`
 
 	var id string
 	err = tx.QueryRowContext(ctx, queryBooking,
-		b.UserID, b.FlightID, b.SeatNumber,
-		b.PassengerName, b.PassengerPassport,
-		b.PriceCents, b.Currency, b.Status,
+		b.PNR, b.UserID, b.FlightID, b.PriceCents, b.Currency, b.Status, b.IdempotencyKey, b.RequestHash,
+		b.DepartureTime,
 	).Scan(&id)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrUniqueViolation {
+			switch pgErr.ConstraintName {
+			case "unique_booking_idempotency_key":
+				return "", domain.ErrIdempotencyKeyExists
+			case "unique_booking_pnr":
+				return "", domain.ErrPNRTaken
+			}
+		}
 		return "", fmt.Errorf("failed to create booking: %w", err)
 	}
 
-	payload := events.PaymentRequestEvent{
-		BookingID:   id,
-		UserID:      b.UserID,
+	queryItem := `
+		INSERT INTO booking_items (
+			booking_id, seat_number, passenger_id, passenger_name, passenger_passport,
+			document_type, citizenship, price_cents
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	for _, item := range b.Items {
+		if _, err := tx.ExecContext(ctx, queryItem,
+			id, item.SeatNumber, item.PassengerID, item.PassengerName, item.PassengerPassport,
+			item.DocumentType, item.Citizenship, item.PriceCents,
+		); err != nil {
+			return "", fmt.Errorf("failed to create booking item for seat %s: %w", item.SeatNumber, err)
+		}
+	}
+
+	createdBy := domain.StatusChange{
+		Actor:   domain.ActorUser,
+		ActorID: strconv.FormatInt(b.UserID, 10),
+		Reason:  "booking created",
+	}
+	if err := r.insertStatusHistory(ctx, tx, id, nil, b.Status, createdBy); err != nil {
+		return "", err
+	}
+
+	payload := &eventsv1.PaymentRequest{
+		BookingId:   id,
+		UserId:      b.UserID,
 		AmountCents: b.PriceCents,
 		Currency:    b.Currency,
 	}
 
-	payloadBytes, err := json.Marshal(payload)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal outbox payload: %w", err)
+	if err := r.insertOutboxEvent(ctx, tx, repository.EventTypePaymentRequest, payload); err != nil {
+		return "", err
 	}
 
-	queryOutbox := `
-		INSERT INTO booking_outbox (event_type, payload, status)
-		VALUES ($1, $2, $3)
-	`
-	if _, err := tx.ExecContext(ctx, queryOutbox, repository.EventTypePaymentRequest, payloadBytes, repository.OutboxStatusPending); err != nil {
-		return "", fmt.Errorf("failed to insert outbox event: %w", err)
+	if err := r.linkSaga(ctx, tx, sagaID, id); err != nil {
+		return "", err
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -85,17 +135,235 @@ func (r *BookingRepo) GetByID(ctx context.Context, id string) (*domain.Booking,
 		return nil, fmt.Errorf("failed to get booking: %w", err)
 	}
 
+	items, err := r.getItems(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+	booking.Items = items
+
+	return &booking, nil
+}
+
+func (r *BookingRepo) GetByIdempotencyKey(ctx context.Context, userID int64, key string) (*domain.Booking, error) {
+	var booking domain.Booking
+	query := `SELECT * FROM bookings WHERE user_id = $1 AND idempotency_key = $2`
+
+	if err := r.db.GetContext(ctx, &booking, query, userID, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrBookingNotFound
+		}
+		return nil, fmt.Errorf("failed to get booking by idempotency key: %w", err)
+	}
+
 	return &booking, nil
 }
 
-func (r *BookingRepo) UpdateStatus(ctx context.Context, id string, status domain.BookingStatus) error {
+func (r *BookingRepo) GetByPNR(ctx context.Context, pnr string) (*domain.Booking, error) {
+	var booking domain.Booking
+	query := `SELECT * FROM bookings WHERE pnr = $1`
+
+	if err := r.db.GetContext(ctx, &booking, query, pnr); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("booking %s: %w", pnr, domain.ErrBookingNotFound)
+		}
+		return nil, fmt.Errorf("failed to get booking by pnr: %w", err)
+	}
+
+	items, err := r.getItems(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+	booking.Items = items
+
+	return &booking, nil
+}
+
+func (r *BookingRepo) UpdateStatus(ctx context.Context, id string, from, to domain.BookingStatus, change domain.StatusChange) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.updateStatus(ctx, tx, id, from, to, change); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BookingRepo) RequestRefund(ctx context.Context, b *domain.Booking, change domain.StatusChange) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.updateStatus(ctx, tx, b.ID, domain.StatusPaid, domain.StatusRefundPending, change); err != nil {
+		return err
+	}
+
+	if err := r.insertRefundRequest(ctx, tx, b, change.Reason); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmPayment completes the saga of a booking whose seats are confirmed:
+// the pending booking moves to PAID, an e-ticket is issued for every
+// passenger and the capture of the authorized payment is queued in the same
+// transaction. The capture takes what the booking costs now, and the
+// surcharges of seat changes made while it was pending are queued after it.
+func (r *BookingRepo) ConfirmPayment(ctx context.Context, bookingID, sagaID, airlineCode string, change domain.StatusChange) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.updateStatus(ctx, tx, bookingID, domain.StatusPending, domain.StatusPaid, change); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO tickets (booking_id, booking_item_id, ticket_number)
+		SELECT booking_id, id, $2 || LPAD(nextval('ticket_serial_seq')::TEXT, 10, '0')
+		FROM booking_items
+		WHERE booking_id = $1
+		ORDER BY seat_number
+	`
+	if _, err := tx.ExecContext(ctx, query, bookingID, airlineCode); err != nil {
+		return fmt.Errorf("failed to issue tickets: %w", err)
+	}
+
+	var b domain.Booking
+	if err := tx.GetContext(ctx, &b, `SELECT * FROM bookings WHERE id = $1`, bookingID); err != nil {
+		return fmt.Errorf("failed to get booking: %w", err)
+	}
+
+	payload := &eventsv1.PaymentCaptureRequest{BookingId: bookingID, AmountCents: b.PriceCents}
+	if err := r.insertOutboxEvent(ctx, tx, repository.EventTypePaymentCaptureRequest, payload); err != nil {
+		return err
+	}
+
+	if err := r.requestSurcharges(ctx, tx, &b); err != nil {
+		return err
+	}
+
+	if err := updateSagaState(ctx, tx, sagaID, domain.SagaConfirmingSeats, domain.SagaCompleted, ""); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BookingRepo) GetTickets(ctx context.Context, bookingID string) ([]domain.Ticket, error) {
+	var tickets []domain.Ticket
+	query := `SELECT * FROM tickets WHERE booking_id = $1 ORDER BY ticket_number`
+
+	if err := r.db.SelectContext(ctx, &tickets, query, bookingID); err != nil {
+		return nil, fmt.Errorf("failed to get tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// CheckIn moves a paid booking to CHECKED_IN and issues a boarding pass per
+// passenger. Sequence numbers are allocated per flight under an advisory lock.
+func (r *BookingRepo) CheckIn(ctx context.Context, b *domain.Booking, change domain.StatusChange) ([]domain.BoardingPass, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.updateStatus(ctx, tx, b.ID, domain.StatusPaid, domain.StatusCheckedIn, change); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, b.FlightID); err != nil {
+		return nil, fmt.Errorf("failed to lock flight sequence: %w", err)
+	}
+
+	var lastSeq int
+	querySeq := `SELECT COALESCE(MAX(sequence_number), 0) FROM boarding_passes WHERE flight_id = $1`
+	if err := tx.GetContext(ctx, &lastSeq, querySeq, b.FlightID); err != nil {
+		return nil, fmt.Errorf("failed to get last sequence number: %w", err)
+	}
+
+	queryPass := `
+		INSERT INTO boarding_passes (booking_item_id, booking_id, flight_id, sequence_number)
+		VALUES ($1, $2, $3, $4)
+		RETURNING issued_at
+	`
+	passes := make([]domain.BoardingPass, 0, len(b.Items))
+	for i, item := range b.Items {
+		pass := domain.BoardingPass{
+			BookingItemID:  item.ID,
+			BookingID:      b.ID,
+			FlightID:       b.FlightID,
+			SequenceNumber: lastSeq + i + 1,
+		}
+		err := tx.QueryRowContext(ctx, queryPass,
+			pass.BookingItemID, pass.BookingID, pass.FlightID, pass.SequenceNumber,
+		).Scan(&pass.IssuedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue boarding pass for seat %s: %w", item.SeatNumber, err)
+		}
+		passes = append(passes, pass)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return passes, nil
+}
+
+func (r *BookingRepo) GetBoardingPasses(ctx context.Context, bookingID string) ([]domain.BoardingPass, error) {
+	var passes []domain.BoardingPass
+	query := `SELECT * FROM boarding_passes WHERE booking_id = $1 ORDER BY sequence_number`
+
+	if err := r.db.SelectContext(ctx, &passes, query, bookingID); err != nil {
+		return nil, fmt.Errorf("failed to get boarding passes: %w", err)
+	}
+
+	return passes, nil
+}
+
+func (r *BookingRepo) updateStatus(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	id string,
+	from, to domain.BookingStatus,
+	change domain.StatusChange,
+) error {
+	// Status changes made for a consumed message are recorded with it, so a
+	// redelivery fails here rather than on the status check below.
+	if err := r.inbox.Record(ctx, tx, id); err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE bookings
 		SET status = $1, updated_at = NOW()
-		WHERE id = $2 AND status = 'PENDING'
+		WHERE id = $2 AND status = $3
 	`
 
-	result, err := r.db.ExecContext(ctx, query, status, id)
+	result, err := tx.ExecContext(ctx, query, to, id, from)
 	if err != nil {
 		return fmt.Errorf("failed to execute update status: %w", err)
 	}
@@ -105,18 +373,108 @@ func (r *BookingRepo) UpdateStatus(ctx context.Context, id string, status domain
 		return fmt.Errorf("failed to check rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("booking %s not found or already processed: %w", id, domain.ErrBookingNotFound)
+		return fmt.Errorf("booking %s is not %s: %w", id, from, domain.ErrStatusChanged)
+	}
+
+	if err := r.advanceSaga(ctx, tx, id, from, to, change); err != nil {
+		return err
+	}
+
+	return r.insertStatusHistory(ctx, tx, id, &from, to, change)
+}
+
+func (r *BookingRepo) GetStatusHistory(ctx context.Context, bookingID string) ([]domain.StatusHistoryEntry, error) {
+	var history []domain.StatusHistoryEntry
+	query := `
+		SELECT * FROM booking_status_history
+		WHERE booking_id = $1
+		ORDER BY created_at, id
+	`
+
+	if err := r.db.SelectContext(ctx, &history, query, bookingID); err != nil {
+		return nil, fmt.Errorf("failed to get booking status history: %w", err)
+	}
+
+	if history == nil {
+		history = []domain.StatusHistoryEntry{}
+	}
+
+	return history, nil
+}
+
+func (r *BookingRepo) insertOutboxEvent(ctx context.Context, tx *sqlx.Tx, eventType string, payload interface{}) error {
+	return r.outbox.Write(ctx, tx, eventType, payload)
+}
+
+func (r *BookingRepo) insertStatusHistory(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	bookingID string,
+	from *domain.BookingStatus,
+	to domain.BookingStatus,
+	change domain.StatusChange,
+) error {
+	query := `
+		INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, actor_id, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	var actorID *string
+	if change.ActorID != "" {
+		actorID = &change.ActorID
 	}
 
+	if _, err := tx.ExecContext(ctx, query, bookingID, from, to, change.Actor, actorID, change.Reason); err != nil {
+		return fmt.Errorf("failed to insert status history: %w", err)
+	}
 	return nil
 }
 
-func (r *BookingRepo) ListByUserID(ctx context.Context, userID int64) ([]domain.Booking, error) {
-	var bookings []domain.Booking
-	query := `SELECT * FROM bookings WHERE user_id = $1 ORDER BY created_at DESC`
+func (r *BookingRepo) List(ctx context.Context, f domain.BookingFilter) ([]domain.Booking, error) {
+	where := []string{"user_id = ?"}
+	args := []interface{}{f.UserID}
 
-	err := r.db.SelectContext(ctx, &bookings, query, userID)
+	if len(f.Statuses) > 0 {
+		where = append(where, "status IN (?)")
+		args = append(args, f.Statuses)
+	}
+	if f.FlightID > 0 {
+		where = append(where, "flight_id = ?")
+		args = append(args, f.FlightID)
+	}
+	if f.DepartureFrom != nil {
+		where = append(where, "departure_time >= ?")
+		args = append(args, *f.DepartureFrom)
+	}
+	if f.DepartureTo != nil {
+		where = append(where, "departure_time < ?")
+		args = append(args, *f.DepartureTo)
+	}
+
+	order := "DESC"
+	cmp := "<"
+	if f.OldestFirst {
+		order = "ASC"
+		cmp = ">"
+	}
+	if f.After != nil {
+		where = append(where, fmt.Sprintf("(created_at, id) %s (?, ?)", cmp))
+		args = append(args, f.After.CreatedAt, f.After.ID)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT * FROM bookings WHERE %s ORDER BY created_at %s, id %s LIMIT ?",
+		strings.Join(where, " AND "), order, order,
+	)
+	args = append(args, f.Limit)
+
+	query, args, err := sqlx.In(query, args...)
 	if err != nil {
+		return nil, fmt.Errorf("failed to build bookings query: %w", err)
+	}
+
+	var bookings []domain.Booking
+	if err := r.db.SelectContext(ctx, &bookings, r.db.Rebind(query), args...); err != nil {
 		return nil, fmt.Errorf("failed to get bookings: %w", err)
 	}
 
@@ -124,6 +482,10 @@ func (r *BookingRepo) ListByUserID(ctx context.Context, userID int64) ([]domain.
 		bookings = []domain.Booking{}
 	}
 
+	if err := r.attachItems(ctx, bookings); err != nil {
+		return nil, err
+	}
+
 	return bookings, nil
 }
 
@@ -144,72 +506,62 @@ func (r *BookingRepo) GetExpiredBookings(ctx context.Context, ttl time.Duration)
 		bookings = []domain.Booking{}
 	}
 
+	if err := r.attachItems(ctx, bookings); err != nil {
+		return nil, err
+	}
+
 	return bookings, nil
 }
 
-func (r *BookingRepo) GetPendingOutboxEvents(ctx context.Context, limit int) ([]repository.OutboxEvent, error) {
-	var outboxEvents []repository.OutboxEvent
-
+func (r *BookingRepo) getItems(ctx context.Context, bookingID string) ([]domain.BookingItem, error) {
+	var items []domain.BookingItem
 	query := `
-		SELECT id, event_type, payload, status
-		FROM booking_outbox
-		WHERE status = $1
-		ORDER BY created_at DESC
-		LIMIT $2
-		FOR UPDATE SKIP LOCKED
+		SELECT bi.*, t.ticket_number
+		FROM booking_items bi
+		LEFT JOIN tickets t ON t.booking_item_id = bi.id AND t.coupon_number = 1
+		WHERE bi.booking_id = $1
+		ORDER BY bi.seat_number
 	`
 
-	if err := r.db.SelectContext(ctx, &outboxEvents, query, repository.OutboxStatusPending, limit); err != nil {
-		return nil, fmt.Errorf("failed to fetch pending outbox events: %w", err)
+	if err := r.db.SelectContext(ctx, &items, query, bookingID); err != nil {
+		return nil, fmt.Errorf("failed to get booking items: %w", err)
 	}
 
-	if outboxEvents == nil {
-		outboxEvents = []repository.OutboxEvent{}
-	}
-	return outboxEvents, nil
+	return items, nil
 }
 
-func (r *BookingRepo) MarkOutboxEventProcessed(ctx context.Context, id string) error {
-	query := `
-		UPDATE booking_outbox
- 		SET status = $1, processed_at = NOW()
-		WHERE id = $2
-	`
+func (r *BookingRepo) attachItems(ctx context.Context, bookings []domain.Booking) error {
+	if len(bookings) == 0 {
+		return nil
+	}
 
-	result, err := r.db.ExecContext(ctx, query, repository.OutboxStatusProcessed, id)
-	if err != nil {
-		return fmt.Errorf("failed to mark outbox event as processeed: %w", err)
+	ids := make([]string, 0, len(bookings))
+	for _, b := range bookings {
+		ids = append(ids, b.ID)
 	}
 
-	rows, err := result.RowsAffected()
+	query, args, err := sqlx.In(`
+		SELECT bi.*, t.ticket_number
+		FROM booking_items bi
+		LEFT JOIN tickets t ON t.booking_item_id = bi.id AND t.coupon_number = 1
+		WHERE bi.booking_id IN (?)
+		ORDER BY bi.seat_number
+	`, ids)
 	if err != nil {
-		return fmt.Errorf("failed to check rows affected: %w", err)
-	}
-	if rows == 0 {
-		return domain.ErrBookingNotFound
+		return fmt.Errorf("failed to build booking items query: %w", err)
 	}
 
-	return nil
-}
-
-func (r *BookingRepo) MarkOutboxEventFailed(ctx context.Context, id string, reason string) error {
-	query := `
-		UPDATE booking_outbox
-		SET status = $1, processed_at = NOW(), error_message = $2
-		WHERE id = $3
-	`
-
-	result, err := r.db.ExecContext(ctx, query, repository.OutboxStatusFailed, reason, id)
-	if err != nil {
-		return fmt.Errorf("failed to mark outbox event as failed: %w", err)
+	var items []domain.BookingItem
+	if err := r.db.SelectContext(ctx, &items, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to get booking items: %w", err)
 	}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to check rows affected: %w", err)
+	byBooking := make(map[string][]domain.BookingItem, len(bookings))
+	for _, item := range items {
+		byBooking[item.BookingID] = append(byBooking[item.BookingID], item)
 	}
-	if rows == 0 {
-		return domain.ErrBookingNotFound
+	for i := range bookings {
+		bookings[i].Items = byBooking[bookings[i].ID]
 	}
 
 	return nil
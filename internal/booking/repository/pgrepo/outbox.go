@@ -0,0 +1,22 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/pkg/outbox"
+)
+
+func (r *BookingRepo) ListDeadOutboxEvents(ctx context.Context, limit int) ([]outbox.Event, error) {
+	return r.outboxEvents.ListDead(ctx, limit)
+}
+
+func (r *BookingRepo) ReplayOutboxEvent(ctx context.Context, id string) error {
+	if err := r.outboxEvents.Replay(ctx, id); err != nil {
+		if errors.Is(err, outbox.ErrEventNotFound) {
+			return domain.ErrOutboxEventNotFound
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/repository"
+)
+
+func (r *BookingRepo) GetByFlight(ctx context.Context, flightID int64, statuses []domain.BookingStatus) ([]domain.Booking, error) {
+	query, args, err := sqlx.In(`
+		SELECT * FROM bookings
+		WHERE flight_id = ? AND status IN (?)
+		ORDER BY created_at
+	`, flightID, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build bookings query: %w", err)
+	}
+
+	var bookings []domain.Booking
+	if err := r.db.SelectContext(ctx, &bookings, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("failed to get bookings by flight: %w", err)
+	}
+
+	if bookings == nil {
+		bookings = []domain.Booking{}
+	}
+
+	if err := r.attachItems(ctx, bookings); err != nil {
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+// CancelForFlight moves a booking of a cancelled flight to FLIGHT_CANCELLED.
+// Paid bookings get a refund request in the same transaction, pending ones
+// give up their unpaid seat changes. The offer is stored when a replacement
+// flight was found.
+func (r *BookingRepo) CancelForFlight(
+	ctx context.Context,
+	b *domain.Booking,
+	offer *domain.RebookingOffer,
+	change domain.StatusChange,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.updateStatus(ctx, tx, b.ID, b.Status, domain.StatusFlightCancelled, change); err != nil {
+		return err
+	}
+
+	if b.IsPaid() {
+		if err := r.insertRefundRequest(ctx, tx, b, change.Reason); err != nil {
+			return err
+		}
+	}
+
+	if b.Status == domain.StatusPending {
+		// The seats went away with the flight, the new seats included.
+		if err := failUnpaidSeatChanges(ctx, tx, b.ID); err != nil {
+			return err
+		}
+	}
+
+	if offer != nil {
+		query := `
+			INSERT INTO booking_rebooking_offers (booking_id, flight_id, departure_time)
+			VALUES ($1, $2, $3)
+		`
+		if _, err := tx.ExecContext(ctx, query, b.ID, offer.FlightID, offer.DepartureTime); err != nil {
+			return fmt.Errorf("failed to insert rebooking offer: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// EnqueueRefund requests a refund without touching the booking status, it is
+// used when a payment lands on a booking whose flight was already cancelled.
+func (r *BookingRepo) EnqueueRefund(ctx context.Context, b *domain.Booking) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.inbox.Record(ctx, tx, b.ID); err != nil {
+		return err
+	}
+
+	if err := r.insertRefundRequest(ctx, tx, b, "payment arrived after flight cancellation"); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// insertRefundRequest queues the refund of everything paid for the booking.
+// The payment service works out the amount from the captured payments, the
+// booking's price does not know which surcharges went through.
+func (r *BookingRepo) insertRefundRequest(ctx context.Context, tx *sqlx.Tx, b *domain.Booking, reason string) error {
+	key, err := domain.NewRefundKey(b.ID)
+	if err != nil {
+		return err
+	}
+
+	payload := &eventsv1.RefundRequest{
+		BookingId: b.ID,
+		UserId:    b.UserID,
+		Currency:  b.Currency,
+		RefundKey: key,
+		Reason:    reason,
+		Full:      true,
+	}
+	return r.insertOutboxEvent(ctx, tx, repository.EventTypeRefundRequest, payload)
+}
+
+func (r *BookingRepo) GetRebookingOffer(ctx context.Context, bookingID string) (*domain.RebookingOffer, error) {
+	var offer domain.RebookingOffer
+	query := `SELECT * FROM booking_rebooking_offers WHERE booking_id = $1`
+
+	if err := r.db.GetContext(ctx, &offer, query, bookingID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRebookingOfferNotFound
+		}
+		return nil, fmt.Errorf("failed to get rebooking offer: %w", err)
+	}
+
+	return &offer, nil
+}
+
+// ClaimRebookingOffer marks the offer accepted so that two concurrent
+// requests cannot both rebook the same booking.
+func (r *BookingRepo) ClaimRebookingOffer(ctx context.Context, bookingID string) error {
+	return r.setRebookingOfferStatus(ctx, bookingID, domain.RebookingOffered, domain.RebookingAccepted)
+}
+
+// ReleaseRebookingOffer reopens a claimed offer after the rebooking failed.
+func (r *BookingRepo) ReleaseRebookingOffer(ctx context.Context, bookingID string) error {
+	return r.setRebookingOfferStatus(ctx, bookingID, domain.RebookingAccepted, domain.RebookingOffered)
+}
+
+// CompleteRebooking links the new booking to the offer. The offer is
+// accepted again if a failed concurrent attempt reopened it meanwhile, the
+// booking placed under the offer's key is the rebooking either way.
+func (r *BookingRepo) CompleteRebooking(ctx context.Context, bookingID, newBookingID string) error {
+	query := `
+		UPDATE booking_rebooking_offers
+		SET status = $3, new_booking_id = $2, updated_at = NOW()
+		WHERE booking_id = $1 AND (new_booking_id IS NULL OR new_booking_id = $2)
+	`
+
+	res, err := r.db.ExecContext(ctx, query, bookingID, newBookingID, domain.RebookingAccepted)
+	if err != nil {
+		return fmt.Errorf("failed to complete rebooking: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("booking %s: %w", bookingID, domain.ErrRebookingOfferTaken)
+	}
+
+	return nil
+}
+
+func (r *BookingRepo) setRebookingOfferStatus(ctx context.Context, bookingID string, from, to domain.RebookingOfferStatus) error {
+	query := `
+		UPDATE booking_rebooking_offers
+		SET status = $3, updated_at = NOW()
+		WHERE booking_id = $1 AND status = $2
+	`
+
+	res, err := r.db.ExecContext(ctx, query, bookingID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to update rebooking offer: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("booking %s: %w", bookingID, domain.ErrRebookingOfferTaken)
+	}
+
+	return nil
+}
@@ -0,0 +1,381 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/repository"
+	"strings"
+	"time"
+)
+
+// sagaColumns reads reserved_seats as a comma separated string, seat numbers
+// never contain commas and database/sql cannot scan a TEXT[] on its own.
+const sagaColumns = `
+	id, booking_id, user_id, flight_id, state,
+	array_to_string(reserved_seats, ',') AS reserved_seats,
+	failure_reason, attempts, last_error, next_attempt_at, created_at, updated_at
+`
+
+type sagaRow struct {
+	domain.Saga
+	ReservedSeats string `db:"reserved_seats"`
+}
+
+func (row sagaRow) toDomain() domain.Saga {
+	saga := row.Saga
+	saga.ReservedSeats = []string{}
+	if row.ReservedSeats != "" {
+		saga.ReservedSeats = strings.Split(row.ReservedSeats, ",")
+	}
+	return saga
+}
+
+// CreateSaga stores a saga about to reserve seats. The caller owns its first
+// step for lease, after that the step is due for recovery.
+func (r *BookingRepo) CreateSaga(ctx context.Context, s *domain.Saga, lease time.Duration) (string, error) {
+	query := `
+		INSERT INTO booking_sagas (user_id, flight_id, state, next_attempt_at)
+		VALUES ($1, $2, $3, NOW() + $4 * INTERVAL '1 millisecond')
+		RETURNING id
+	`
+
+	var id string
+	if err := r.db.QueryRowContext(ctx, query, s.UserID, s.FlightID, s.State, lease.Milliseconds()).Scan(&id); err != nil {
+		return "", fmt.Errorf("failed to create saga: %w", err)
+	}
+
+	return id, nil
+}
+
+// RecordSeatReserved adds a seat the saga holds in the flight service. It
+// fails once the saga stopped reserving, recovery only releases the seats
+// recorded by then.
+func (r *BookingRepo) RecordSeatReserved(ctx context.Context, sagaID, seatNumber string) error {
+	query := `
+		UPDATE booking_sagas
+		SET reserved_seats = array_append(reserved_seats, $1), updated_at = NOW()
+		WHERE id = $2 AND state = $3
+	`
+	result, err := r.db.ExecContext(ctx, query, seatNumber, sagaID, domain.SagaReservingSeats)
+	if err != nil {
+		return fmt.Errorf("failed to record reserved seat: %w", err)
+	}
+
+	return sagaRowsAffected(result, sagaID, domain.SagaReservingSeats)
+}
+
+// UpdateSagaState moves a saga between states and makes the new state's step
+// due right away. reason is kept as the failure reason when not empty.
+func (r *BookingRepo) UpdateSagaState(ctx context.Context, id string, from, to domain.SagaState, reason string) error {
+	return updateSagaState(ctx, r.db, id, from, to, reason)
+}
+
+// RecordPaymentAuthorized moves the saga of a booking whose payment has been
+// authorized on to confirming the seats.
+func (r *BookingRepo) RecordPaymentAuthorized(ctx context.Context, bookingID string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.inbox.Record(ctx, tx, bookingID); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE booking_sagas
+		SET state = $1, attempts = 0, last_error = NULL, next_attempt_at = NOW(), updated_at = NOW()
+		WHERE booking_id = $2 AND state = $3
+	`
+	result, err := tx.ExecContext(ctx, query, domain.SagaConfirmingSeats, bookingID, domain.SagaAwaitingPayment)
+	if err != nil {
+		return fmt.Errorf("failed to record payment authorization: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("saga of booking %s is not %s: %w", bookingID, domain.SagaAwaitingPayment, domain.ErrSagaStateChanged)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// VoidPayment gives up the authorized payment of a booking whose seats could
+// not be confirmed: it queues the void request and moves the saga on to next.
+// A booking still PENDING fails with change in the same transaction.
+func (r *BookingRepo) VoidPayment(
+	ctx context.Context,
+	b *domain.Booking,
+	sagaID string,
+	next domain.SagaState,
+	change domain.StatusChange,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if b.Status == domain.StatusPending {
+		if err := r.updateStatus(ctx, tx, b.ID, domain.StatusPending, domain.StatusFailed, change); err != nil {
+			return err
+		}
+	}
+
+	payload := &eventsv1.PaymentVoidRequest{
+		BookingId: b.ID,
+		Reason:    change.Reason,
+	}
+	if err := r.insertOutboxEvent(ctx, tx, repository.EventTypePaymentVoidRequest, payload); err != nil {
+		return err
+	}
+
+	if err := updateSagaState(ctx, tx, sagaID, domain.SagaConfirmingSeats, next, change.Reason); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimSaga takes the due step of a saga for lease. It returns ErrSagaBusy
+// when the step is not due, another replica is running it then.
+func (r *BookingRepo) ClaimSaga(ctx context.Context, id string, lease time.Duration) (*domain.Saga, error) {
+	query := fmt.Sprintf(`
+		UPDATE booking_sagas
+		SET next_attempt_at = NOW() + $1 * INTERVAL '1 millisecond'
+		WHERE id = $2 AND next_attempt_at <= NOW()
+		RETURNING %s
+	`, sagaColumns)
+
+	var row sagaRow
+	if err := r.db.GetContext(ctx, &row, query, lease.Milliseconds(), id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("saga %s: %w", id, domain.ErrSagaBusy)
+		}
+		return nil, fmt.Errorf("failed to claim saga: %w", err)
+	}
+
+	saga := row.toDomain()
+	return &saga, nil
+}
+
+// ClaimDueSagas takes up to limit sagas whose step is due, oldest first.
+func (r *BookingRepo) ClaimDueSagas(ctx context.Context, lease time.Duration, limit int) ([]domain.Saga, error) {
+	query := fmt.Sprintf(`
+		UPDATE booking_sagas
+		SET next_attempt_at = NOW() + $1 * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM booking_sagas
+			WHERE state IN ($2, $3, $4) AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING %s
+	`, sagaColumns)
+
+	var rows []sagaRow
+	err := r.db.SelectContext(ctx, &rows, query, lease.Milliseconds(),
+		domain.SagaReservingSeats, domain.SagaConfirmingSeats, domain.SagaCompensating, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due sagas: %w", err)
+	}
+
+	return sagasToDomain(rows), nil
+}
+
+// RetrySagaStep counts a failed step and postpones it by delay.
+func (r *BookingRepo) RetrySagaStep(ctx context.Context, id string, delay time.Duration, reason string) error {
+	query := `
+		UPDATE booking_sagas
+		SET attempts = attempts + 1,
+		    last_error = $1,
+		    next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond',
+		    updated_at = NOW()
+		WHERE id = $3
+	`
+	if _, err := r.db.ExecContext(ctx, query, reason, delay.Milliseconds(), id); err != nil {
+		return fmt.Errorf("failed to schedule saga retry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BookingRepo) GetSagaByBooking(ctx context.Context, bookingID string) (*domain.Saga, error) {
+	query := fmt.Sprintf(`SELECT %s FROM booking_sagas WHERE booking_id = $1`, sagaColumns)
+
+	var row sagaRow
+	if err := r.db.GetContext(ctx, &row, query, bookingID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("booking %s: %w", bookingID, domain.ErrSagaNotFound)
+		}
+		return nil, fmt.Errorf("failed to get saga: %w", err)
+	}
+
+	saga := row.toDomain()
+	return &saga, nil
+}
+
+// ListSagas returns the sagas matching the filter, newest first.
+func (r *BookingRepo) ListSagas(ctx context.Context, f domain.SagaFilter) ([]domain.Saga, error) {
+	where := []string{"TRUE"}
+	var args []interface{}
+
+	if f.State != "" {
+		where = append(where, "state = ?")
+		args = append(args, f.State)
+	}
+	if f.BookingID != "" {
+		where = append(where, "booking_id = ?")
+		args = append(args, f.BookingID)
+	}
+	args = append(args, f.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM booking_sagas
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT ?
+	`, sagaColumns, strings.Join(where, " AND "))
+
+	var rows []sagaRow
+	if err := r.db.SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("failed to list sagas: %w", err)
+	}
+
+	return sagasToDomain(rows), nil
+}
+
+// linkSaga attaches the stored booking to its saga, which waits for the
+// payment from then on.
+func (r *BookingRepo) linkSaga(ctx context.Context, tx *sqlx.Tx, sagaID, bookingID string) error {
+	query := `
+		UPDATE booking_sagas
+		SET booking_id = $1, state = $2, next_attempt_at = NOW(), updated_at = NOW()
+		WHERE id = $3 AND state = $4
+	`
+	result, err := tx.ExecContext(ctx, query, bookingID, domain.SagaAwaitingPayment, sagaID, domain.SagaReservingSeats)
+	if err != nil {
+		return fmt.Errorf("failed to link saga: %w", err)
+	}
+
+	return sagaRowsAffected(result, sagaID, domain.SagaReservingSeats)
+}
+
+// advanceSaga moves the saga of a booking along with the booking's status.
+// Bookings only have a saga while it waits for the payment.
+func (r *BookingRepo) advanceSaga(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	bookingID string,
+	from, to domain.BookingStatus,
+	change domain.StatusChange,
+) error {
+	state, ok := domain.SagaStateAfter(from, to)
+	if !ok {
+		return nil
+	}
+
+	var reason string
+	if state == domain.SagaCompensating || state == domain.SagaCompensated {
+		reason = change.Reason
+	}
+
+	query := `
+		UPDATE booking_sagas
+		SET state = $1,
+		    failure_reason = COALESCE(NULLIF($2, ''), failure_reason),
+		    attempts = 0,
+		    last_error = NULL,
+		    next_attempt_at = NOW(),
+		    updated_at = NOW()
+		WHERE booking_id = $3 AND state = $4
+	`
+	if _, err := tx.ExecContext(ctx, query, state, reason, bookingID, domain.SagaAwaitingPayment); err != nil {
+		return fmt.Errorf("failed to advance saga: %w", err)
+	}
+
+	return nil
+}
+
+// EnqueueVoid requests the void of a payment authorized after its booking
+// ended, the booking and its saga are left as they are.
+func (r *BookingRepo) EnqueueVoid(ctx context.Context, b *domain.Booking, reason string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.inbox.Record(ctx, tx, b.ID); err != nil {
+		return err
+	}
+
+	payload := &eventsv1.PaymentVoidRequest{
+		BookingId: b.ID,
+		Reason:    reason,
+	}
+	if err := r.insertOutboxEvent(ctx, tx, repository.EventTypePaymentVoidRequest, payload); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func updateSagaState(ctx context.Context, db sqlx.ExecerContext, id string, from, to domain.SagaState, reason string) error {
+	query := `
+		UPDATE booking_sagas
+		SET state = $1,
+		    failure_reason = COALESCE(NULLIF($2, ''), failure_reason),
+		    attempts = 0,
+		    last_error = NULL,
+		    next_attempt_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $3 AND state = $4
+	`
+	result, err := db.ExecContext(ctx, query, to, reason, id, from)
+	if err != nil {
+		return fmt.Errorf("failed to update saga state: %w", err)
+	}
+
+	return sagaRowsAffected(result, id, from)
+}
+
+func sagaRowsAffected(result sql.Result, id string, from domain.SagaState) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("saga %s is not %s: %w", id, from, domain.ErrSagaStateChanged)
+	}
+	return nil
+}
+
+func sagasToDomain(rows []sagaRow) []domain.Saga {
+	sagas := make([]domain.Saga, 0, len(rows))
+	for _, row := range rows {
+		sagas = append(sagas, row.toDomain())
+	}
+	return sagas
+}
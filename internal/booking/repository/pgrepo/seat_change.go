@@ -0,0 +1,312 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/repository"
+)
+
+const seatChangeColumns = `
+	id, booking_id, booking_item_id, old_seat_number, new_seat_number,
+	old_price_cents, new_price_cents, status, created_at
+`
+
+// ChangeSeat records a seat change of a booking still in b.Status. A change
+// that costs extra waits for its surcharge, see ApplySeatChange; a paid
+// booking queues the surcharge now, a pending one once it is paid, see
+// ConfirmPayment. Any other change is applied at once. A paid booking moving
+// to a cheaper seat gets the difference refunded, a pending one has only its
+// new price captured.
+func (r *BookingRepo) ChangeSeat(ctx context.Context, b *domain.Booking, sc *domain.SeatChange, change domain.StatusChange) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockBooking(ctx, tx, b.ID, b.Status); err != nil {
+		return err
+	}
+
+	var pending bool
+	queryPending := `
+		SELECT EXISTS (
+			SELECT 1 FROM booking_seat_changes WHERE booking_id = $1 AND status = $2
+		)
+	`
+	if err := tx.GetContext(ctx, &pending, queryPending, b.ID, domain.SeatChangeAwaitingPayment); err != nil {
+		return fmt.Errorf("failed to check pending seat changes: %w", err)
+	}
+	if pending {
+		return domain.ErrSeatChangePending
+	}
+
+	diff := sc.PriceDifferenceCents()
+
+	sc.Status = domain.SeatChangeApplied
+	if diff > 0 {
+		sc.Status = domain.SeatChangeAwaitingPayment
+	}
+
+	querySeatChange := `
+		INSERT INTO booking_seat_changes (
+			booking_id, booking_item_id, old_seat_number, new_seat_number, old_price_cents, new_price_cents, status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+	err = tx.QueryRowContext(ctx, querySeatChange,
+		sc.BookingID, sc.BookingItemID, sc.OldSeatNumber, sc.NewSeatNumber, sc.OldPriceCents, sc.NewPriceCents, sc.Status,
+	).Scan(&sc.ID, &sc.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record seat change: %w", err)
+	}
+
+	if diff > 0 {
+		if b.Status == domain.StatusPaid {
+			if err := r.insertSurchargeRequest(ctx, tx, b, sc); err != nil {
+				return err
+			}
+		}
+	} else {
+		if err := r.applySeatChange(ctx, tx, b, sc, change); err != nil {
+			return err
+		}
+		if diff < 0 && b.Status == domain.StatusPaid {
+			if err := r.insertSeatChangeRefund(ctx, tx, b, sc, -diff, "moved to a cheaper seat"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// ListSeatChanges returns the booking's seat changes in status, oldest first.
+func (r *BookingRepo) ListSeatChanges(ctx context.Context, bookingID string, status domain.SeatChangeStatus) ([]domain.SeatChange, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM booking_seat_changes
+		WHERE booking_id = $1 AND status = $2
+		ORDER BY created_at
+	`, seatChangeColumns)
+
+	var changes []domain.SeatChange
+	if err := r.db.SelectContext(ctx, &changes, query, bookingID, status); err != nil {
+		return nil, fmt.Errorf("failed to list seat changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+func (r *BookingRepo) GetSeatChange(ctx context.Context, id string) (*domain.SeatChange, error) {
+	query := fmt.Sprintf(`SELECT %s FROM booking_seat_changes WHERE id = $1`, seatChangeColumns)
+
+	var sc domain.SeatChange
+	if err := r.db.GetContext(ctx, &sc, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSeatChangeNotFound
+		}
+		return nil, fmt.Errorf("failed to get seat change: %w", err)
+	}
+
+	return &sc, nil
+}
+
+// ApplySeatChange moves the passenger to the new seat once the surcharge is
+// paid. It fails with ErrStatusChanged when the booking is no longer paid and
+// with ErrSeatChangeSettled when the change is not awaiting payment.
+func (r *BookingRepo) ApplySeatChange(ctx context.Context, b *domain.Booking, sc *domain.SeatChange, change domain.StatusChange) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.inbox.Record(ctx, tx, sc.ID); err != nil {
+		return err
+	}
+
+	if err := settleSeatChange(ctx, tx, sc, domain.SeatChangeApplied); err != nil {
+		return err
+	}
+
+	if err := lockBooking(ctx, tx, b.ID, domain.StatusPaid); err != nil {
+		return err
+	}
+
+	if err := r.applySeatChange(ctx, tx, b, sc, change); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// FailSeatChange gives up a seat change awaiting payment, the booking keeps
+// its old seat. A surcharge that was taken is refunded when refund is set.
+func (r *BookingRepo) FailSeatChange(ctx context.Context, b *domain.Booking, sc *domain.SeatChange, refund bool) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.inbox.Record(ctx, tx, sc.ID); err != nil {
+		return err
+	}
+
+	if err := settleSeatChange(ctx, tx, sc, domain.SeatChangeFailed); err != nil {
+		return err
+	}
+
+	if refund {
+		if err := r.insertSeatChangeRefund(ctx, tx, b, sc, sc.PriceDifferenceCents(), "seat change failed"); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// FailUnpaidSeatChanges gives up the seat changes of a booking that ended
+// before it was paid, their surcharges were never requested.
+func (r *BookingRepo) FailUnpaidSeatChanges(ctx context.Context, bookingID string) error {
+	return failUnpaidSeatChanges(ctx, r.db, bookingID)
+}
+
+// requestSurcharges queues the surcharges of the seat changes a booking made
+// while pending, the booking must be paid and locked by the caller.
+func (r *BookingRepo) requestSurcharges(ctx context.Context, tx *sqlx.Tx, b *domain.Booking) error {
+	query := fmt.Sprintf(`
+		SELECT %s FROM booking_seat_changes
+		WHERE booking_id = $1 AND status = $2
+		ORDER BY created_at
+	`, seatChangeColumns)
+
+	var changes []domain.SeatChange
+	if err := tx.SelectContext(ctx, &changes, query, b.ID, domain.SeatChangeAwaitingPayment); err != nil {
+		return fmt.Errorf("failed to get seat changes awaiting payment: %w", err)
+	}
+
+	for i := range changes {
+		if err := r.insertSurchargeRequest(ctx, tx, b, &changes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *BookingRepo) insertSurchargeRequest(ctx context.Context, tx *sqlx.Tx, b *domain.Booking, sc *domain.SeatChange) error {
+	payload := &eventsv1.PaymentRequest{
+		BookingId:   b.ID,
+		UserId:      b.UserID,
+		AmountCents: sc.PriceDifferenceCents(),
+		Currency:    b.Currency,
+		Reference:   sc.ID,
+	}
+	return r.insertOutboxEvent(ctx, tx, repository.EventTypePaymentRequest, payload)
+}
+
+func failUnpaidSeatChanges(ctx context.Context, db sqlx.ExecerContext, bookingID string) error {
+	query := `UPDATE booking_seat_changes SET status = $1 WHERE booking_id = $2 AND status = $3`
+
+	if _, err := db.ExecContext(ctx, query, domain.SeatChangeFailed, bookingID, domain.SeatChangeAwaitingPayment); err != nil {
+		return fmt.Errorf("failed to fail unpaid seat changes: %w", err)
+	}
+	return nil
+}
+
+// applySeatChange moves the booking item to the new seat and adjusts the
+// booking's price, the booking must be locked by the caller.
+func (r *BookingRepo) applySeatChange(ctx context.Context, tx *sqlx.Tx, b *domain.Booking, sc *domain.SeatChange, change domain.StatusChange) error {
+	queryBooking := `
+		UPDATE bookings
+		SET price_cents = price_cents + $1, updated_at = NOW()
+		WHERE id = $2
+	`
+	if _, err := tx.ExecContext(ctx, queryBooking, sc.PriceDifferenceCents(), b.ID); err != nil {
+		return fmt.Errorf("failed to update booking price: %w", err)
+	}
+
+	queryItem := `
+		UPDATE booking_items
+		SET seat_number = $1, price_cents = $2
+		WHERE id = $3 AND seat_number = $4
+	`
+	result, err := tx.ExecContext(ctx, queryItem, sc.NewSeatNumber, sc.NewPriceCents, sc.BookingItemID, sc.OldSeatNumber)
+	if err != nil {
+		return fmt.Errorf("failed to update booking item: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("seat %s: %w", sc.OldSeatNumber, domain.ErrSeatNotInBooking)
+	}
+
+	return r.insertStatusHistory(ctx, tx, b.ID, &b.Status, b.Status, change)
+}
+
+func (r *BookingRepo) insertSeatChangeRefund(ctx context.Context, tx *sqlx.Tx, b *domain.Booking, sc *domain.SeatChange, amountCents int64, reason string) error {
+	payload := &eventsv1.RefundRequest{
+		BookingId:   b.ID,
+		UserId:      b.UserID,
+		AmountCents: amountCents,
+		Currency:    b.Currency,
+		RefundKey:   sc.RefundKey(),
+		Reason:      reason,
+	}
+	return r.insertOutboxEvent(ctx, tx, repository.EventTypeRefundRequest, payload)
+}
+
+func settleSeatChange(ctx context.Context, tx *sqlx.Tx, sc *domain.SeatChange, to domain.SeatChangeStatus) error {
+	query := `UPDATE booking_seat_changes SET status = $1 WHERE id = $2 AND status = $3`
+
+	result, err := tx.ExecContext(ctx, query, to, sc.ID, domain.SeatChangeAwaitingPayment)
+	if err != nil {
+		return fmt.Errorf("failed to update seat change: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("seat change %s: %w", sc.ID, domain.ErrSeatChangeSettled)
+	}
+
+	sc.Status = to
+	return nil
+}
+
+// lockBooking locks a booking for the rest of the transaction, it fails with
+// ErrStatusChanged when the booking is not in status.
+func lockBooking(ctx context.Context, tx *sqlx.Tx, id string, status domain.BookingStatus) error {
+	var locked string
+	query := `SELECT id FROM bookings WHERE id = $1 AND status = $2 FOR UPDATE`
+
+	if err := tx.GetContext(ctx, &locked, query, id, status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("booking %s is not %s: %w", id, status, domain.ErrStatusChanged)
+		}
+		return fmt.Errorf("failed to lock booking: %w", err)
+	}
+
+	return nil
+}
@@ -2,39 +2,61 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/domain/events"
+	"github.com/squ1ky/flyte/pkg/outbox"
 	"time"
 )
 
 const (
-	EventTypePaymentRequest = "PAYMENT_REQUEST"
-)
-
-type OutboxStatus string
+	EventTypePaymentRequest = events.EventTypePaymentRequest
+	EventTypeRefundRequest  = events.EventTypeRefundRequest
 
-const (
-	OutboxStatusPending   OutboxStatus = "PENDING"
-	OutboxStatusProcessed OutboxStatus = "PROCESSED"
-	OutboxStatusFailed    OutboxStatus = "FAILED"
+	EventTypePaymentCaptureRequest = events.EventTypePaymentCaptureRequest
+	EventTypePaymentVoidRequest    = events.EventTypePaymentVoidRequest
 )
 
-type OutboxEvent struct {
-	ID        string          `db:"id"`
-	EventType string          `db:"event_type"`
-	Payload   json.RawMessage `db:"payload"`
-	Status    OutboxStatus    `db:"status"`
-	CreatedAt time.Time       `db:"created_at"`
-}
-
 type BookingRepository interface {
-	Create(ctx context.Context, booking *domain.Booking) (string, error)
+	Create(ctx context.Context, booking *domain.Booking, sagaID string) (string, error)
 	GetByID(ctx context.Context, id string) (*domain.Booking, error)
-	UpdateStatus(ctx context.Context, id string, status domain.BookingStatus) error
-	ListByUserID(ctx context.Context, userID int64) ([]domain.Booking, error)
+	GetByPNR(ctx context.Context, pnr string) (*domain.Booking, error)
+	GetByIdempotencyKey(ctx context.Context, userID int64, key string) (*domain.Booking, error)
+	UpdateStatus(ctx context.Context, id string, from, to domain.BookingStatus, change domain.StatusChange) error
+	RequestRefund(ctx context.Context, booking *domain.Booking, change domain.StatusChange) error
+	ChangeSeat(ctx context.Context, booking *domain.Booking, seatChange *domain.SeatChange, change domain.StatusChange) error
+	ListSeatChanges(ctx context.Context, bookingID string, status domain.SeatChangeStatus) ([]domain.SeatChange, error)
+	GetSeatChange(ctx context.Context, id string) (*domain.SeatChange, error)
+	ApplySeatChange(ctx context.Context, booking *domain.Booking, seatChange *domain.SeatChange, change domain.StatusChange) error
+	FailSeatChange(ctx context.Context, booking *domain.Booking, seatChange *domain.SeatChange, refund bool) error
+	FailUnpaidSeatChanges(ctx context.Context, bookingID string) error
+	ConfirmPayment(ctx context.Context, bookingID, sagaID, airlineCode string, change domain.StatusChange) error
+	GetTickets(ctx context.Context, bookingID string) ([]domain.Ticket, error)
+	CheckIn(ctx context.Context, booking *domain.Booking, change domain.StatusChange) ([]domain.BoardingPass, error)
+	GetBoardingPasses(ctx context.Context, bookingID string) ([]domain.BoardingPass, error)
+	GetStatusHistory(ctx context.Context, bookingID string) ([]domain.StatusHistoryEntry, error)
+	List(ctx context.Context, filter domain.BookingFilter) ([]domain.Booking, error)
 	GetExpiredBookings(ctx context.Context, ttl time.Duration) ([]domain.Booking, error)
 
-	GetPendingOutboxEvents(ctx context.Context, limit int) ([]OutboxEvent, error)
-	MarkOutboxEventProcessed(ctx context.Context, id string) error
-	MarkOutboxEventFailed(ctx context.Context, id string, reason string) error
+	GetByFlight(ctx context.Context, flightID int64, statuses []domain.BookingStatus) ([]domain.Booking, error)
+	CancelForFlight(ctx context.Context, booking *domain.Booking, offer *domain.RebookingOffer, change domain.StatusChange) error
+	EnqueueRefund(ctx context.Context, booking *domain.Booking) error
+	GetRebookingOffer(ctx context.Context, bookingID string) (*domain.RebookingOffer, error)
+	ClaimRebookingOffer(ctx context.Context, bookingID string) error
+	ReleaseRebookingOffer(ctx context.Context, bookingID string) error
+	CompleteRebooking(ctx context.Context, bookingID, newBookingID string) error
+
+	CreateSaga(ctx context.Context, saga *domain.Saga, lease time.Duration) (string, error)
+	RecordSeatReserved(ctx context.Context, sagaID, seatNumber string) error
+	UpdateSagaState(ctx context.Context, id string, from, to domain.SagaState, reason string) error
+	RecordPaymentAuthorized(ctx context.Context, bookingID string) error
+	EnqueueVoid(ctx context.Context, booking *domain.Booking, reason string) error
+	VoidPayment(ctx context.Context, booking *domain.Booking, sagaID string, next domain.SagaState, change domain.StatusChange) error
+	ClaimSaga(ctx context.Context, id string, lease time.Duration) (*domain.Saga, error)
+	ClaimDueSagas(ctx context.Context, lease time.Duration, limit int) ([]domain.Saga, error)
+	RetrySagaStep(ctx context.Context, id string, delay time.Duration, reason string) error
+	GetSagaByBooking(ctx context.Context, bookingID string) (*domain.Saga, error)
+	ListSagas(ctx context.Context, filter domain.SagaFilter) ([]domain.Saga, error)
+
+	ListDeadOutboxEvents(ctx context.Context, limit int) ([]outbox.Event, error)
+	ReplayOutboxEvent(ctx context.Context, id string) error
 }
@@ -5,113 +5,293 @@ import (
 	"errors"
 	"fmt"
 	"github.com/squ1ky/flyte/internal/booking/clients/grpc/flight"
+	"github.com/squ1ky/flyte/internal/booking/clients/grpc/user"
 	"github.com/squ1ky/flyte/internal/booking/domain"
 	"github.com/squ1ky/flyte/internal/booking/domain/events"
-	"github.com/squ1ky/flyte/internal/booking/kafka"
 	"github.com/squ1ky/flyte/internal/booking/repository"
+	"github.com/squ1ky/flyte/pkg/inbox"
 	"log/slog"
+	"strconv"
 )
 
+// Config holds the business settings of the booking service.
+type Config struct {
+	CheckInWindow domain.CheckInWindow
+	// TicketAirlineCode is the 3-digit accounting code e-ticket numbers start with.
+	TicketAirlineCode string
+	Saga              SagaConfig
+}
+
 type BookingService struct {
 	repo         repository.BookingRepository
-	producer     *kafka.PaymentEventProducer
 	flightClient *flight.Client
+	userClient   *user.Client
+	cfg          Config
 	log          *slog.Logger
 }
 
 func NewBookingService(
 	repo repository.BookingRepository,
-	producer *kafka.PaymentEventProducer,
 	flightClient *flight.Client,
+	userClient *user.Client,
+	cfg Config,
 	log *slog.Logger,
 ) *BookingService {
 	return &BookingService{
 		repo:         repo,
-		producer:     producer,
 		flightClient: flightClient,
+		userClient:   userClient,
+		cfg:          cfg,
 		log:          log,
 	}
 }
 
 type CreateBookingDTO struct {
-	UserID            int64
-	FlightID          int64
+	UserID           int64
+	FlightID         int64
+	QuotedPriceCents int64
+	Items            []CreateBookingItemDTO
+	IdempotencyKey   string
+}
+
+// CreateBookingItemDTO either references a saved passenger profile by
+// PassengerID or carries the passenger data inline.
+type CreateBookingItemDTO struct {
 	SeatNumber        string
-	PriceCents        int64
-	Currency          string
+	PassengerID       int64
 	PassengerName     string
 	PassengerPassport string
 }
 
 func (s *BookingService) CreateBooking(ctx context.Context, dto CreateBookingDTO) (string, error) {
+	if dto.IdempotencyKey == "" {
+		return s.createBooking(ctx, dto, "")
+	}
+
+	hash := requestHash(dto)
+	if id, ok, err := s.replayBooking(ctx, dto, hash); err != nil || ok {
+		return id, err
+	}
+
+	id, err := s.createBooking(ctx, dto, hash)
+	if errors.Is(err, domain.ErrSeatAlreadyBooked) || errors.Is(err, domain.ErrIdempotencyKeyExists) {
+		// A concurrent retry with the same key may have taken the seats first,
+		// answer with its booking instead of a conflict.
+		if replayID, ok, replayErr := s.replayBooking(ctx, dto, hash); replayErr != nil || ok {
+			return replayID, replayErr
+		}
+	}
+	return id, err
+}
+
+func (s *BookingService) createBooking(ctx context.Context, dto CreateBookingDTO, hash string) (string, error) {
 	log := s.log.With("user_id", dto.UserID, "flight_id", dto.FlightID)
 
-	if err := s.flightClient.ReserveSeat(ctx, dto.FlightID, dto.SeatNumber); err != nil {
-		log.Error("failed to reserve seat", "error", err)
-		return "", fmt.Errorf("failed to reserve seat: %w", err)
+	seatNumbers := make([]string, 0, len(dto.Items))
+	for _, item := range dto.Items {
+		seatNumbers = append(seatNumbers, item.SeatNumber)
+	}
+
+	quote, err := s.QuoteBooking(ctx, dto.FlightID, seatNumbers)
+	if err != nil {
+		log.Warn("failed to quote booking", "error", err)
+		return "", fmt.Errorf("failed to quote booking: %w", err)
+	}
+	if quote.TotalCents != dto.QuotedPriceCents {
+		log.Warn("quoted price mismatch", "quoted", dto.QuotedPriceCents, "actual", quote.TotalCents)
+		return "", fmt.Errorf("expected %d, got %d: %w", dto.QuotedPriceCents, quote.TotalCents, domain.ErrPriceChanged)
+	}
+
+	profiles, err := s.loadPassengerProfiles(ctx, dto.UserID, dto.Items)
+	if err != nil {
+		log.Warn("failed to load passenger profiles", "error", err)
+		return "", fmt.Errorf("failed to load passenger profiles: %w", err)
 	}
 
 	booking := &domain.Booking{
-		UserID:            dto.UserID,
-		FlightID:          dto.FlightID,
-		SeatNumber:        dto.SeatNumber,
-		PriceCents:        dto.PriceCents,
-		Currency:          dto.Currency,
-		PassengerName:     dto.PassengerName,
-		PassengerPassport: dto.PassengerPassport,
-		Status:            domain.StatusPending,
+		UserID:     dto.UserID,
+		FlightID:   dto.FlightID,
+		PriceCents: quote.TotalCents,
+		Currency:   quote.Currency,
+		Status:     domain.StatusPending,
+		Items:      make([]domain.BookingItem, 0, len(dto.Items)),
+	}
+	if !quote.DepartureTime.IsZero() {
+		booking.DepartureTime = &quote.DepartureTime
 	}
+	if dto.IdempotencyKey != "" {
+		booking.IdempotencyKey = &dto.IdempotencyKey
+		booking.RequestHash = &hash
+	}
+	for i, item := range dto.Items {
+		bookingItem := domain.BookingItem{
+			SeatNumber:        item.SeatNumber,
+			PassengerName:     item.PassengerName,
+			PassengerPassport: item.PassengerPassport,
+			PriceCents:        quote.Items[i].PriceCents,
+		}
+		if item.PassengerID > 0 {
+			profile := profiles[item.PassengerID]
+			bookingItem.PassengerID = &profile.ID
+			bookingItem.PassengerName = profile.FullName()
+			bookingItem.PassengerPassport = profile.DocumentNumber
+			bookingItem.DocumentType = profile.DocumentType
+			bookingItem.Citizenship = profile.Citizenship
+		}
+		booking.Items = append(booking.Items, bookingItem)
+	}
+
+	return s.placeBooking(ctx, booking)
+}
 
-	id, err := s.repo.Create(ctx, booking)
+// loadPassengerProfiles fetches the saved profiles referenced by the items
+// and makes sure every one of them belongs to the user.
+func (s *BookingService) loadPassengerProfiles(
+	ctx context.Context,
+	userID int64,
+	items []CreateBookingItemDTO,
+) (map[int64]domain.PassengerProfile, error) {
+	var needed bool
+	for _, item := range items {
+		if item.PassengerID > 0 {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil, nil
+	}
+
+	saved, err := s.userClient.GetPassengers(ctx, userID)
 	if err != nil {
-		log.Error("failed to create booking, releasing seat", "error", err)
-		if releaseErr := s.flightClient.ReleaseSeat(ctx, dto.FlightID, dto.SeatNumber); releaseErr != nil {
-			log.Error("failed to release seat during rollback", "error", releaseErr)
+		return nil, err
+	}
+
+	owned := make(map[int64]domain.PassengerProfile, len(saved))
+	for _, p := range saved {
+		owned[p.ID] = p
+	}
+
+	profiles := make(map[int64]domain.PassengerProfile)
+	for _, item := range items {
+		if item.PassengerID <= 0 {
+			continue
 		}
-		return "", fmt.Errorf("failed to create booking: %w", err)
+		profile, ok := owned[item.PassengerID]
+		if !ok {
+			return nil, fmt.Errorf("passenger %d: %w", item.PassengerID, domain.ErrPassengerNotFound)
+		}
+		profiles[item.PassengerID] = profile
 	}
 
-	return id, nil
+	return profiles, nil
 }
 
-func (s *BookingService) GetBooking(ctx context.Context, id string) (*domain.Booking, error) {
-	return s.repo.GetByID(ctx, id)
+func (s *BookingService) GetBooking(ctx context.Context, id string, caller domain.Caller) (*domain.Booking, error) {
+	return s.getOwnedBooking(ctx, id, caller)
 }
 
-func (s *BookingService) ListBookings(ctx context.Context, userID int64) ([]domain.Booking, error) {
-	return s.repo.ListByUserID(ctx, userID)
+func (s *BookingService) GetBookingHistory(ctx context.Context, id string, caller domain.Caller) ([]domain.StatusHistoryEntry, error) {
+	if _, err := s.getOwnedBooking(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	return s.repo.GetStatusHistory(ctx, id)
 }
 
-func (s *BookingService) CancelBooking(ctx context.Context, bookingID string) error {
-	log := s.log.With("booking_id", bookingID)
+func (s *BookingService) getOwnedBooking(ctx context.Context, id string, caller domain.Caller) (*domain.Booking, error) {
+	booking, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
-	booking, err := s.repo.GetByID(ctx, bookingID)
+	if !caller.CanAccess(booking) {
+		s.log.Warn("access to foreign booking denied",
+			"booking_id", id,
+			"caller_id", caller.UserID)
+		return nil, fmt.Errorf("booking %s: %w", id, domain.ErrBookingAccessDenied)
+	}
+
+	return booking, nil
+}
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// ListBookings returns one page of the user's bookings and the cursor of the
+// next page, which is nil on the last page.
+func (s *BookingService) ListBookings(ctx context.Context, filter domain.BookingFilter) ([]domain.Booking, *domain.BookingCursor, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultPageSize
+	}
+	if filter.Limit > MaxPageSize {
+		filter.Limit = MaxPageSize
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+
+	bookings, err := s.repo.List(ctx, filter)
 	if err != nil {
-		log.Error("failed to fetch booking: %w", err)
-		return fmt.Errorf("failed to fetch booking: %w", err)
+		return nil, nil, err
+	}
+
+	if len(bookings) <= pageSize {
+		return bookings, nil, nil
 	}
 
-	if booking.Status.IsTerminal() {
-		log.Warn("cannot cancel booking in terminal status", "status", booking.Status)
-		return fmt.Errorf("cannot cancel booking with status %s", booking.Status)
+	bookings = bookings[:pageSize]
+	return bookings, domain.CursorFor(&bookings[pageSize-1]), nil
+}
+
+func (s *BookingService) CancelBooking(ctx context.Context, bookingID string, caller domain.Caller) error {
+	log := s.log.With("booking_id", bookingID, "caller_id", caller.UserID)
+
+	booking, err := s.getOwnedBooking(ctx, bookingID, caller)
+	if err != nil {
+		log.Error("failed to fetch booking", "error", err)
+		return fmt.Errorf("failed to fetch booking: %w", err)
 	}
 
-	if err := s.repo.UpdateStatus(ctx, bookingID, domain.StatusCancelled); err != nil {
-		log.Error("failed to update status to cancelled", "error", err)
-		return fmt.Errorf("failed to update status to cancelled: %w", err)
+	change := domain.StatusChange{
+		Actor:   domain.ActorUser,
+		ActorID: strconv.FormatInt(caller.UserID, 10),
+		Reason:  "cancelled by user",
+	}
+	if caller.IsAdmin() && caller.UserID != booking.UserID {
+		change.Reason = "cancelled by admin"
 	}
 
-	if err := s.flightClient.ReleaseSeat(ctx, booking.FlightID, booking.SeatNumber); err != nil {
-		log.Error("failed to release seat during cancellation", "error", err)
-		return fmt.Errorf("booking cancelled locally but failed to release seat in flight-service: %w", err)
+	switch booking.Status {
+	case domain.StatusPending:
+		if err := s.repo.UpdateStatus(ctx, bookingID, domain.StatusPending, domain.StatusCancelled, change); err != nil {
+			log.Error("failed to update status to cancelled", "error", err)
+			return fmt.Errorf("failed to update status to cancelled: %w", err)
+		}
+
+		s.resumeBookingSaga(ctx, bookingID)
+
+		log.Info("booking cancelled successfully")
+	case domain.StatusPaid:
+		change.Reason += ", refund requested"
+		if err := s.repo.RequestRefund(ctx, booking, change); err != nil {
+			log.Error("failed to request refund", "error", err)
+			return fmt.Errorf("failed to request refund: %w", err)
+		}
+
+		log.Info("paid booking cancelled, refund requested")
+	default:
+		log.Warn("cannot cancel booking", "status", booking.Status)
+		return fmt.Errorf("status %s: %w", booking.Status, domain.ErrNotCancellable)
 	}
 
-	log.Info("booking cancelled successfully")
 	return nil
 }
 
-func (s *BookingService) ProcessPaymentResult(ctx context.Context, bookingID string, status events.PaymentStatus) error {
-	log := s.log.With("booking_id", bookingID, "status", status)
+func (s *BookingService) ProcessPaymentResult(ctx context.Context, res events.PaymentResultEvent) error {
+	bookingID := res.BookingID
+	log := s.log.With("booking_id", bookingID, "status", res.Status)
 
 	booking, err := s.repo.GetByID(ctx, bookingID)
 	if err != nil {
@@ -122,37 +302,201 @@ func (s *BookingService) ProcessPaymentResult(ctx context.Context, bookingID str
 		return fmt.Errorf("failed to get booking: %w", err)
 	}
 
-	switch status {
-	case events.PaymentStatusSuccess:
-		if booking.Status.IsTerminal() {
-			log.Info("booking already in terminal state", "current_status", booking.Status)
+	if res.Reference != "" {
+		// Additional charges are seat change surcharges, they settle the seat
+		// change and never move the booking itself.
+		return s.processSurchargeResult(ctx, booking, res)
+	}
+
+	switch res.Status {
+	case events.PaymentStatusAuthorized, events.PaymentStatusSuccess:
+		// SUCCESS answers requests made before payments were authorized first,
+		// both go on to confirming the seats.
+		if booking.Status == domain.StatusFlightCancelled && res.Status == events.PaymentStatusSuccess {
+			// The flight was cancelled while the payment was in flight, hand the
+			// money straight back.
+			if err := s.repo.EnqueueRefund(ctx, booking); err != nil {
+				return fmt.Errorf("failed to request refund: %w", err)
+			}
+			log.Info("payment arrived after flight cancellation, refund requested")
 			return nil
 		}
-
-		err = s.repo.UpdateStatus(ctx, bookingID, domain.StatusPaid)
-		if err != nil {
-			log.Warn("failed to update local status to PAID", "error", err)
-			return fmt.Errorf("failed to update status: %w", err)
+		if booking.Status != domain.StatusPending {
+			if res.Status == events.PaymentStatusAuthorized && !booking.IsPaid() {
+				// The booking did not wait for the payment, release the money held.
+				reason := fmt.Sprintf("booking is %s", booking.Status)
+				if err := s.repo.EnqueueVoid(ctx, booking, reason); err != nil {
+					return fmt.Errorf("failed to request void: %w", err)
+				}
+				log.Info("payment authorized after booking ended, void requested", "current_status", booking.Status)
+				return nil
+			}
+			log.Info("booking already processed", "current_status", booking.Status)
+			return nil
 		}
-		log.Info("booking successfully confirmed and paid")
 
-		err = s.flightClient.ConfirmSeat(ctx, booking.FlightID, booking.SeatNumber)
+		err := s.repo.RecordPaymentAuthorized(ctx, bookingID)
+		if errors.Is(err, inbox.ErrDuplicate) {
+			return err
+		}
+		if errors.Is(err, domain.ErrSagaStateChanged) {
+			log.Info("payment authorization already recorded", "error", err)
+			return nil
+		}
 		if err != nil {
-			log.Error("status updated to PAID but failed to confirm seat", "error", err)
+			log.Warn("failed to record payment authorization", "error", err)
+			return fmt.Errorf("failed to record payment authorization: %w", err)
 		}
+		log.Info("payment authorized, confirming seats")
+
+		s.resumeBookingSaga(ctx, bookingID)
 	case events.PaymentStatusFailed:
 		log.Info("payment failed, cancelling booking")
 
-		err := s.repo.UpdateStatus(ctx, bookingID, domain.StatusFailed)
+		reason := "payment failed"
+		if res.ErrorMessage != "" {
+			reason = fmt.Sprintf("payment failed: %s", res.ErrorMessage)
+		}
+		change := domain.StatusChange{
+			Actor:   domain.ActorPaymentEvent,
+			ActorID: res.PaymentID,
+			Reason:  reason,
+		}
+		err := s.repo.UpdateStatus(ctx, bookingID, domain.StatusPending, domain.StatusFailed, change)
+		if errors.Is(err, inbox.ErrDuplicate) {
+			return err
+		}
 		if err != nil {
 			log.Warn("booking cancellation skipped", "error", err)
+			return nil
 		}
 
-		err = s.flightClient.ReleaseSeat(ctx, booking.FlightID, booking.SeatNumber)
-		if err != nil {
-			log.Warn("failed to release seat", "error", err)
+		s.resumeBookingSaga(ctx, bookingID)
+	}
+
+	return nil
+}
+
+func (s *BookingService) ProcessRefundResult(ctx context.Context, res events.PaymentResultEvent) error {
+	bookingID := res.BookingID
+	log := s.log.With("booking_id", bookingID, "status", res.Status, "refund_key", res.RefundKey)
+
+	if !domain.IsBookingRefundKey(bookingID, res.RefundKey) {
+		log.Info("ignoring result of a refund the booking did not request")
+		return nil
+	}
+
+	booking, err := s.repo.GetByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			log.Error("booking not found for refund result")
+			return nil
 		}
+		return fmt.Errorf("failed to get booking: %w", err)
+	}
+
+	if booking.Status == domain.StatusFlightCancelled {
+		return s.processFlightCancelledRefund(ctx, booking, res)
+	}
+	if booking.Status != domain.StatusRefundPending {
+		log.Info("booking is not awaiting refund", "current_status", booking.Status)
+		return nil
+	}
+
+	switch res.Status {
+	case events.PaymentStatusSuccess:
+		change := domain.StatusChange{
+			Actor:   domain.ActorPaymentEvent,
+			ActorID: res.PaymentID,
+			Reason:  "refund succeeded",
+		}
+		if err := s.repo.UpdateStatus(ctx, bookingID, domain.StatusRefundPending, domain.StatusRefunded, change); err != nil {
+			log.Warn("failed to update local status to REFUNDED", "error", err)
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		log.Info("booking refunded")
+
+		if err := s.flightClient.ReleaseSeats(ctx, booking.FlightID, booking.SeatNumbers()); err != nil {
+			log.Error("booking refunded but failed to release seats", "error", err)
+		}
+	case events.PaymentStatusFailed:
+		reason := "refund failed"
+		if res.ErrorMessage != "" {
+			reason = fmt.Sprintf("refund failed: %s", res.ErrorMessage)
+		}
+		change := domain.StatusChange{
+			Actor:   domain.ActorPaymentEvent,
+			ActorID: res.PaymentID,
+			Reason:  reason,
+		}
+		if err := s.repo.UpdateStatus(ctx, bookingID, domain.StatusRefundPending, domain.StatusPaid, change); err != nil {
+			log.Warn("failed to revert booking to PAID", "error", err)
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		log.Warn("refund failed, booking stays paid", "reason", res.ErrorMessage)
+	}
+
+	return nil
+}
+
+// ProcessCaptureResult handles the capture of a booking's payment. A booking
+// whose payment could not be captured has not been paid for after all, it
+// fails and its seats go back.
+func (s *BookingService) ProcessCaptureResult(ctx context.Context, res events.PaymentResultEvent) error {
+	bookingID := res.BookingID
+	log := s.log.With("booking_id", bookingID, "status", res.Status)
+
+	if res.Status != events.PaymentStatusFailed {
+		log.Info("booking payment captured")
+		return nil
+	}
+
+	booking, err := s.repo.GetByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			log.Error("booking not found for capture result")
+			return nil
+		}
+		return fmt.Errorf("failed to get booking: %w", err)
+	}
+	if !booking.IsPaid() {
+		log.Warn("capture failed for booking that is not paid", "current_status", booking.Status)
+		return nil
+	}
+
+	reason := "payment capture failed"
+	if res.ErrorMessage != "" {
+		reason = fmt.Sprintf("payment capture failed: %s", res.ErrorMessage)
+	}
+	change := domain.StatusChange{
+		Actor:   domain.ActorPaymentEvent,
+		ActorID: res.PaymentID,
+		Reason:  reason,
+	}
+	if err := s.repo.UpdateStatus(ctx, bookingID, booking.Status, domain.StatusFailed, change); err != nil {
+		log.Warn("failed to fail booking", "error", err)
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+	log.Warn("payment capture failed, booking failed", "reason", res.ErrorMessage)
+
+	if err := s.flightClient.ReleaseSeats(ctx, booking.FlightID, booking.SeatNumbers()); err != nil {
+		log.Error("booking failed but failed to release seats", "error", err)
+	}
+
+	return nil
+}
+
+// ProcessVoidResult handles the void of a booking's payment. Nothing is left
+// to do with the booking, a failed void only leaves the money held until the
+// provider expires the authorization.
+func (s *BookingService) ProcessVoidResult(ctx context.Context, res events.PaymentResultEvent) error {
+	log := s.log.With("booking_id", res.BookingID, "status", res.Status)
+
+	if res.Status == events.PaymentStatusFailed {
+		log.Error("payment void failed", "payment_id", res.PaymentID, "reason", res.ErrorMessage)
+		return nil
 	}
 
+	log.Info("booking payment voided", "payment_id", res.PaymentID)
 	return nil
 }
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/boardingpass"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"strconv"
+	"time"
+)
+
+// CheckIn checks in every passenger of a paid booking and returns their
+// boarding passes. Repeating it for a checked in booking returns the passes
+// issued the first time.
+func (s *BookingService) CheckIn(ctx context.Context, bookingID string, caller domain.Caller) ([]boardingpass.Pass, error) {
+	log := s.log.With("booking_id", bookingID, "caller_id", caller.UserID)
+
+	booking, err := s.getOwnedBooking(ctx, bookingID, caller)
+	if err != nil {
+		log.Error("failed to fetch booking", "error", err)
+		return nil, fmt.Errorf("failed to fetch booking: %w", err)
+	}
+
+	if booking.Status == domain.StatusCheckedIn {
+		return s.boardingPasses(ctx, booking)
+	}
+	if booking.Status != domain.StatusPaid {
+		log.Warn("cannot check in booking", "status", booking.Status)
+		return nil, fmt.Errorf("status %s: %w", booking.Status, domain.ErrNotCheckInable)
+	}
+
+	flight, err := s.flightClient.GetFlight(ctx, booking.FlightID)
+	if err != nil {
+		log.Error("failed to get flight", "error", err)
+		return nil, fmt.Errorf("failed to get flight: %w", err)
+	}
+
+	if err := s.cfg.CheckInWindow.Check(flight.DepartureTime, time.Now()); err != nil {
+		log.Warn("check-in outside of window", "departure_time", flight.DepartureTime, "error", err)
+		return nil, err
+	}
+
+	change := domain.StatusChange{
+		Actor:   domain.ActorUser,
+		ActorID: strconv.FormatInt(caller.UserID, 10),
+		Reason:  "online check-in",
+	}
+	issued, err := s.repo.CheckIn(ctx, booking, change)
+	if err != nil {
+		log.Error("failed to check in", "error", err)
+		return nil, fmt.Errorf("failed to check in: %w", err)
+	}
+
+	log.Info("booking checked in", "passengers", len(issued))
+	return buildPasses(booking, flight, issued), nil
+}
+
+// GetBoardingPasses returns the boarding passes of a checked in booking.
+func (s *BookingService) GetBoardingPasses(ctx context.Context, bookingID string, caller domain.Caller) ([]boardingpass.Pass, error) {
+	booking, err := s.getOwnedBooking(ctx, bookingID, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.Status != domain.StatusCheckedIn {
+		return nil, fmt.Errorf("status %s: %w", booking.Status, domain.ErrNotCheckedIn)
+	}
+
+	return s.boardingPasses(ctx, booking)
+}
+
+func (s *BookingService) boardingPasses(ctx context.Context, booking *domain.Booking) ([]boardingpass.Pass, error) {
+	issued, err := s.repo.GetBoardingPasses(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	flight, err := s.flightClient.GetFlight(ctx, booking.FlightID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flight: %w", err)
+	}
+
+	return buildPasses(booking, flight, issued), nil
+}
+
+func buildPasses(booking *domain.Booking, flight *domain.Flight, issued []domain.BoardingPass) []boardingpass.Pass {
+	itemsByID := make(map[string]domain.BookingItem, len(booking.Items))
+	for _, item := range booking.Items {
+		itemsByID[item.ID] = item
+	}
+
+	passes := make([]boardingpass.Pass, 0, len(issued))
+	for _, bp := range issued {
+		item, ok := itemsByID[bp.BookingItemID]
+		if !ok {
+			continue
+		}
+
+		pass := boardingpass.Pass{
+			PNR:            booking.PNR,
+			PassengerName:  item.PassengerName,
+			SeatNumber:     item.SeatNumber,
+			FlightNumber:   flight.FlightNumber,
+			From:           flight.DepartureAirport,
+			To:             flight.ArrivalAirport,
+			DepartureTime:  flight.LocalDepartureTime(),
+			SequenceNumber: bp.SequenceNumber,
+		}
+		pass.BCBP = boardingpass.EncodeBCBP(pass)
+		passes = append(passes, pass)
+	}
+
+	return passes
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/domain/events"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"strconv"
+)
+
+const maxFlightCancelAttempts = 3
+
+// activeStatuses are the statuses of bookings a flight cancellation affects.
+var activeStatuses = []domain.BookingStatus{
+	domain.StatusPending,
+	domain.StatusPaid,
+	domain.StatusCheckedIn,
+}
+
+// ProcessFlightCancelled moves every active booking of the cancelled flight
+// to FLIGHT_CANCELLED, refunds the paid ones and offers a seat on the next
+// flight of the route. Bookings already moved are skipped, so a redelivered
+// event is harmless.
+func (s *BookingService) ProcessFlightCancelled(ctx context.Context, ev events.FlightCancelledEvent) error {
+	log := s.log.With("flight_id", ev.FlightID)
+
+	bookings, err := s.repo.GetByFlight(ctx, ev.FlightID, activeStatuses)
+	if err != nil {
+		return fmt.Errorf("failed to get bookings of flight: %w", err)
+	}
+	log.Info("flight cancelled, processing bookings", "bookings", len(bookings))
+
+	reason := "flight cancelled"
+	if ev.Reason != "" {
+		reason = fmt.Sprintf("flight cancelled: %s", ev.Reason)
+	}
+	change := domain.StatusChange{
+		Actor:   domain.ActorFlightEvent,
+		ActorID: strconv.FormatInt(ev.FlightID, 10),
+		Reason:  reason,
+	}
+
+	// Bookings with the same number of passengers get the same offer.
+	offers := make(map[int]*domain.RebookingOffer)
+
+	var errs []error
+	for i := range bookings {
+		booking := &bookings[i]
+
+		passengers := len(booking.Items)
+		offer, ok := offers[passengers]
+		if !ok {
+			offer = s.findRebookingOffer(ctx, ev, passengers)
+			offers[passengers] = offer
+		}
+
+		if err := s.cancelForFlight(ctx, booking, offer, change); err != nil {
+			log.Error("failed to cancel booking for flight", "booking_id", booking.ID, "error", err)
+			errs = append(errs, fmt.Errorf("booking %s: %w", booking.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// cancelForFlight retries when the booking status changes underneath, a
+// payment result can move a pending booking to PAID at the same time.
+func (s *BookingService) cancelForFlight(
+	ctx context.Context,
+	booking *domain.Booking,
+	offer *domain.RebookingOffer,
+	change domain.StatusChange,
+) error {
+	for attempt := 1; ; attempt++ {
+		err := s.repo.CancelForFlight(ctx, booking, offer, change)
+		if err == nil {
+			s.log.Info("booking cancelled with its flight",
+				"booking_id", booking.ID,
+				"refund", booking.IsPaid(),
+				"rebooking_offered", offer != nil)
+			return nil
+		}
+		if errors.Is(err, inbox.ErrDuplicate) {
+			// Cancelled by an earlier delivery of the same event.
+			return nil
+		}
+		if !errors.Is(err, domain.ErrStatusChanged) || attempt == maxFlightCancelAttempts {
+			return err
+		}
+
+		booking, err = s.repo.GetByID(ctx, booking.ID)
+		if err != nil {
+			return err
+		}
+		if !isActive(booking.Status) {
+			return nil
+		}
+	}
+}
+
+// processFlightCancelledRefund settles the refund of a booking whose flight
+// was cancelled. A failed refund leaves the booking FLIGHT_CANCELLED, there
+// is no paid state to go back to.
+func (s *BookingService) processFlightCancelledRefund(ctx context.Context, booking *domain.Booking, res events.PaymentResultEvent) error {
+	log := s.log.With("booking_id", booking.ID, "status", res.Status)
+
+	if res.Status == events.PaymentStatusFailed {
+		log.Error("refund of cancelled flight booking failed", "reason", res.ErrorMessage)
+		return nil
+	}
+
+	change := domain.StatusChange{
+		Actor:   domain.ActorPaymentEvent,
+		ActorID: res.PaymentID,
+		Reason:  "refund succeeded",
+	}
+	if err := s.repo.UpdateStatus(ctx, booking.ID, domain.StatusFlightCancelled, domain.StatusRefunded, change); err != nil {
+		log.Warn("failed to update local status to REFUNDED", "error", err)
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	log.Info("cancelled flight booking refunded")
+	return nil
+}
+
+func (s *BookingService) findRebookingOffer(ctx context.Context, ev events.FlightCancelledEvent, passengers int) *domain.RebookingOffer {
+	next, err := s.flightClient.FindNextFlight(ctx, ev.DepartureAirport, ev.ArrivalAirport,
+		ev.DepartureTime, passengers, ev.FlightID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrFlightNotFound) {
+			s.log.Error("failed to find rebooking flight", "flight_id", ev.FlightID, "error", err)
+		}
+		return nil
+	}
+
+	return &domain.RebookingOffer{
+		FlightID:      next.ID,
+		DepartureTime: next.DepartureTime,
+		Status:        domain.RebookingOffered,
+	}
+}
+
+func isActive(status domain.BookingStatus) bool {
+	for _, st := range activeStatuses {
+		if st == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *BookingService) GetRebookingOffer(ctx context.Context, bookingID string, caller domain.Caller) (*domain.RebookingOffer, error) {
+	if _, err := s.getOwnedBooking(ctx, bookingID, caller); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRebookingOffer(ctx, bookingID)
+}
+
+// AcceptRebooking books the passengers of a cancelled booking on the offered
+// flight. Passengers keep their seat numbers where those are free, the new
+// booking is priced at the new flight's fare and paid like any other one.
+func (s *BookingService) AcceptRebooking(ctx context.Context, bookingID string, caller domain.Caller) (string, error) {
+	log := s.log.With("booking_id", bookingID, "caller_id", caller.UserID)
+
+	booking, err := s.getOwnedBooking(ctx, bookingID, caller)
+	if err != nil {
+		return "", err
+	}
+
+	offer, err := s.repo.GetRebookingOffer(ctx, bookingID)
+	if err != nil {
+		return "", err
+	}
+	if offer.Status == domain.RebookingAccepted && offer.NewBookingID != nil {
+		return *offer.NewBookingID, nil
+	}
+
+	// An accepted offer without a new booking is one whose rebooking was cut
+	// short after the claim, it is resumed rather than reported as taken.
+	if offer.Status != domain.RebookingAccepted {
+		if err := s.repo.ClaimRebookingOffer(ctx, bookingID); err != nil {
+			return "", err
+		}
+	}
+
+	newID, err := s.rebook(ctx, booking, offer)
+	if err != nil {
+		log.Error("rebooking failed, reopening offer", "flight_id", offer.FlightID, "error", err)
+		if releaseErr := s.repo.ReleaseRebookingOffer(ctx, bookingID); releaseErr != nil {
+			log.Error("failed to reopen rebooking offer", "error", releaseErr)
+		}
+		return "", err
+	}
+
+	if err := s.repo.CompleteRebooking(ctx, bookingID, newID); err != nil {
+		// The offer stays accepted, a retry finds the new booking by its key
+		// and links it.
+		log.Error("rebooked but failed to link new booking", "new_booking_id", newID, "error", err)
+		return "", err
+	}
+
+	log.Info("booking rebooked", "flight_id", offer.FlightID, "new_booking_id", newID)
+	return newID, nil
+}
+
+// rebook places the new booking under a key derived from the cancelled one,
+// so a resumed or concurrent attempt returns the booking already placed
+// instead of placing a second one.
+func (s *BookingService) rebook(ctx context.Context, booking *domain.Booking, offer *domain.RebookingOffer) (string, error) {
+	key := rebookingKey(booking.ID)
+	if id, ok, err := s.findRebooking(ctx, booking.UserID, key, offer); err != nil || ok {
+		return id, err
+	}
+
+	seats, err := s.flightClient.GetSeats(ctx, offer.FlightID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get seats: %w", err)
+	}
+
+	seatNumbers, err := pickSeats(seats, booking.SeatNumbers())
+	if err != nil {
+		return "", err
+	}
+
+	quote, err := s.QuoteBooking(ctx, offer.FlightID, seatNumbers)
+	if err != nil {
+		return "", fmt.Errorf("failed to quote rebooking: %w", err)
+	}
+
+	rebooked := &domain.Booking{
+		UserID:         booking.UserID,
+		FlightID:       offer.FlightID,
+		PriceCents:     quote.TotalCents,
+		Currency:       quote.Currency,
+		Status:         domain.StatusPending,
+		IdempotencyKey: &key,
+		Items:          make([]domain.BookingItem, 0, len(booking.Items)),
+	}
+	if !quote.DepartureTime.IsZero() {
+		rebooked.DepartureTime = &quote.DepartureTime
+	}
+	for i, item := range booking.Items {
+		rebooked.Items = append(rebooked.Items, domain.BookingItem{
+			SeatNumber:        seatNumbers[i],
+			PassengerID:       item.PassengerID,
+			PassengerName:     item.PassengerName,
+			PassengerPassport: item.PassengerPassport,
+			DocumentType:      item.DocumentType,
+			Citizenship:       item.Citizenship,
+			PriceCents:        quote.Items[i].PriceCents,
+		})
+	}
+
+	id, err := s.placeBooking(ctx, rebooked)
+	if errors.Is(err, domain.ErrSeatAlreadyBooked) || errors.Is(err, domain.ErrIdempotencyKeyExists) {
+		// A concurrent attempt may have placed the booking first.
+		if placedID, ok, findErr := s.findRebooking(ctx, booking.UserID, key, offer); findErr != nil || ok {
+			return placedID, findErr
+		}
+	}
+	return id, err
+}
+
+// findRebooking looks up the booking placed earlier for the offer. It
+// reports ok when there is one.
+func (s *BookingService) findRebooking(ctx context.Context, userID int64, key string, offer *domain.RebookingOffer) (string, bool, error) {
+	placed, err := s.repo.GetByIdempotencyKey(ctx, userID, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to check rebooking: %w", err)
+	}
+
+	if placed.FlightID != offer.FlightID {
+		return "", false, fmt.Errorf("booking %s: %w", placed.ID, domain.ErrIdempotencyKeyReused)
+	}
+	return placed.ID, true, nil
+}
+
+func rebookingKey(bookingID string) string {
+	return "rebooking:" + bookingID
+}
+
+// pickSeats returns one free seat per wanted seat, the same seat number when
+// it is free on the new flight and the first free one otherwise.
+func pickSeats(seats []domain.Seat, wanted []string) ([]string, error) {
+	free := make(map[string]bool, len(seats))
+	for _, seat := range seats {
+		if !seat.IsBooked {
+			free[seat.SeatNumber] = true
+		}
+	}
+
+	picked := make([]string, len(wanted))
+	for i, seat := range wanted {
+		if free[seat] {
+			picked[i] = seat
+			delete(free, seat)
+		}
+	}
+	for i := range picked {
+		if picked[i] != "" {
+			continue
+		}
+		for _, seat := range seats {
+			if free[seat.SeatNumber] {
+				picked[i] = seat.SeatNumber
+				delete(free, seat.SeatNumber)
+				break
+			}
+		}
+		if picked[i] == "" {
+			return nil, domain.ErrNoSeatsForRebooking
+		}
+	}
+
+	return picked, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+)
+
+// replayBooking looks up a booking created earlier with the same idempotency
+// key. It reports ok when the request is a repeat of that booking.
+func (s *BookingService) replayBooking(ctx context.Context, dto CreateBookingDTO, hash string) (string, bool, error) {
+	booking, err := s.repo.GetByIdempotencyKey(ctx, dto.UserID, dto.IdempotencyKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to check idempotency key: %w", err)
+	}
+
+	if booking.RequestHash == nil || *booking.RequestHash != hash {
+		s.log.Warn("idempotency key reused with a different request",
+			"user_id", dto.UserID,
+			"booking_id", booking.ID)
+		return "", false, domain.ErrIdempotencyKeyReused
+	}
+
+	s.log.Info("create booking request replayed",
+		"user_id", dto.UserID,
+		"booking_id", booking.ID)
+	return booking.ID, true, nil
+}
+
+// requestHash fingerprints everything in the request that affects the booking.
+func requestHash(dto CreateBookingDTO) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d|%d|%d", dto.UserID, dto.FlightID, dto.QuotedPriceCents)
+	for _, item := range dto.Items {
+		fmt.Fprintf(h, "|%q|%d|%q|%q", item.SeatNumber, item.PassengerID, item.PassengerName, item.PassengerPassport)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
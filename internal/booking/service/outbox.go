@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/pkg/outbox"
+)
+
+const (
+	DefaultDeadEventsLimit = 50
+	MaxDeadEventsLimit     = 500
+)
+
+// ListDeadOutboxEvents returns the outbox events that gave up publishing,
+// oldest first.
+func (s *BookingService) ListDeadOutboxEvents(ctx context.Context, caller domain.Caller, limit int) ([]outbox.Event, error) {
+	if !caller.IsAdmin() {
+		return nil, domain.ErrAdminOnly
+	}
+
+	if limit <= 0 {
+		limit = DefaultDeadEventsLimit
+	}
+	if limit > MaxDeadEventsLimit {
+		limit = MaxDeadEventsLimit
+	}
+
+	return s.repo.ListDeadOutboxEvents(ctx, limit)
+}
+
+// ReplayOutboxEvent queues a dead event for publishing again.
+func (s *BookingService) ReplayOutboxEvent(ctx context.Context, caller domain.Caller, id string) error {
+	if !caller.IsAdmin() {
+		return domain.ErrAdminOnly
+	}
+
+	if err := s.repo.ReplayOutboxEvent(ctx, id); err != nil {
+		return fmt.Errorf("outbox event %s: %w", id, err)
+	}
+
+	s.log.Info("dead outbox event replayed", "outbox_id", id, "admin_id", caller.UserID)
+	return nil
+}
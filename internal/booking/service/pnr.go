@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"strings"
+)
+
+const maxPNRAttempts = 5
+
+// saveWithPNR assigns a fresh record locator to the booking and stores it,
+// generating another one if the locator is already taken.
+func (s *BookingService) saveWithPNR(ctx context.Context, booking *domain.Booking, sagaID string) (string, error) {
+	for attempt := 1; ; attempt++ {
+		pnr, err := domain.NewPNR()
+		if err != nil {
+			return "", err
+		}
+		booking.PNR = pnr
+
+		id, err := s.repo.Create(ctx, booking, sagaID)
+		if errors.Is(err, domain.ErrPNRTaken) && attempt < maxPNRAttempts {
+			s.log.Warn("pnr collision, regenerating", "pnr", pnr, "attempt", attempt)
+			continue
+		}
+		return id, err
+	}
+}
+
+// LookupBooking finds a booking by its record locator for an unauthenticated
+// traveller. The surname acts as the shared secret, a mismatch is reported
+// as not found so a valid locator cannot be probed.
+func (s *BookingService) LookupBooking(ctx context.Context, pnr, lastName string) (*domain.Booking, error) {
+	booking, err := s.repo.GetByPNR(ctx, strings.ToUpper(pnr))
+	if err != nil {
+		return nil, err
+	}
+
+	if !booking.HasPassengerSurname(lastName) {
+		s.log.Warn("booking lookup with wrong surname", "pnr", booking.PNR)
+		return nil, fmt.Errorf("booking %s: %w", booking.PNR, domain.ErrBookingNotFound)
+	}
+
+	for i := range booking.Items {
+		booking.Items[i].PassengerPassport = maskDocument(booking.Items[i].PassengerPassport)
+	}
+
+	return booking, nil
+}
+
+// maskDocument keeps only the last characters of a document number.
+func maskDocument(number string) string {
+	const visible = 3
+	if len(number) <= visible {
+		return strings.Repeat("*", len(number))
+	}
+	return strings.Repeat("*", len(number)-visible) + number[len(number)-visible:]
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"math"
+)
+
+func (s *BookingService) QuoteBooking(ctx context.Context, flightID int64, seatNumbers []string) (*domain.Quote, error) {
+	flight, err := s.flightClient.GetFlight(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := s.flightClient.GetSeats(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+
+	seatsByNumber := make(map[string]domain.Seat, len(seats))
+	for _, seat := range seats {
+		seatsByNumber[seat.SeatNumber] = seat
+	}
+
+	quote := &domain.Quote{
+		FlightID:      flightID,
+		DepartureTime: flight.DepartureTime,
+		Currency:      domain.DefaultCurrency,
+		Items:         make([]domain.QuoteItem, 0, len(seatNumbers)),
+	}
+	for _, number := range seatNumbers {
+		seat, ok := seatsByNumber[number]
+		if !ok {
+			return nil, fmt.Errorf("seat %s: %w", number, domain.ErrSeatNotFound)
+		}
+		if seat.IsBooked {
+			return nil, fmt.Errorf("seat %s: %w", number, domain.ErrSeatAlreadyBooked)
+		}
+
+		price := calculateFare(flight.BasePriceCents, seat.PriceMultiplier)
+		quote.Items = append(quote.Items, domain.QuoteItem{
+			SeatNumber: number,
+			PriceCents: price,
+		})
+		quote.TotalCents += price
+	}
+
+	return quote, nil
+}
+
+func calculateFare(basePriceCents int64, multiplier float64) int64 {
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	return int64(math.Round(float64(basePriceCents) * multiplier))
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/receipt"
+)
+
+func (s *BookingService) GetItineraryReceipt(ctx context.Context, bookingID string, caller domain.Caller) (*receipt.Receipt, error) {
+	booking, err := s.getOwnedBooking(ctx, bookingID, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := s.repo.GetTickets(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tickets) == 0 {
+		return nil, fmt.Errorf("booking %s: %w", booking.ID, domain.ErrNotTicketed)
+	}
+
+	flight, err := s.flightClient.GetFlight(ctx, booking.FlightID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flight: %w", err)
+	}
+
+	return &receipt.Receipt{
+		Booking:  booking,
+		Flight:   flight,
+		IssuedAt: tickets[0].IssuedAt,
+	}, nil
+}
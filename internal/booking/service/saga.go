@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"time"
+)
+
+// SagaConfig controls how booking saga steps are run and retried.
+type SagaConfig struct {
+	// StepTimeout is how long a replica owns a step it started. A step still
+	// unfinished after it is taken over by the recovery worker.
+	StepTimeout time.Duration
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Backoff returns the delay before the next run of a step that has failed
+// attempts times, doubling from BaseBackoff up to MaxBackoff.
+func (c SagaConfig) Backoff(attempts int) time.Duration {
+	delay := c.BaseBackoff
+	for i := 1; i < attempts && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > c.MaxBackoff {
+		delay = c.MaxBackoff
+	}
+	return delay
+}
+
+const (
+	DefaultSagasLimit = 50
+	MaxSagasLimit     = 500
+)
+
+// placeBooking runs the first steps of the booking saga: it reserves the
+// seats and stores the booking, which queues the payment request. When
+// either fails the saga compensates by releasing the seats it reserved.
+func (s *BookingService) placeBooking(ctx context.Context, booking *domain.Booking) (string, error) {
+	saga := &domain.Saga{
+		UserID:   booking.UserID,
+		FlightID: booking.FlightID,
+		State:    domain.SagaReservingSeats,
+	}
+	sagaID, err := s.repo.CreateSaga(ctx, saga, s.cfg.Saga.StepTimeout)
+	if err != nil {
+		return "", err
+	}
+	log := s.log.With("saga_id", sagaID, "flight_id", booking.FlightID)
+
+	if err := s.reserveSeats(ctx, sagaID, booking.FlightID, booking.SeatNumbers()); err != nil {
+		log.Warn("failed to reserve seats, compensating", "error", err)
+		s.abortSaga(ctx, sagaID, err)
+		return "", fmt.Errorf("failed to reserve seats: %w", err)
+	}
+
+	id, err := s.saveWithPNR(ctx, booking, sagaID)
+	if err != nil {
+		log.Error("failed to create booking, compensating", "error", err)
+		s.abortSaga(ctx, sagaID, err)
+		return "", fmt.Errorf("failed to create booking: %w", err)
+	}
+
+	return id, nil
+}
+
+// reserveSeats reserves the seats one by one and records each of them, so
+// a takeover by the recovery worker knows which seats to release.
+func (s *BookingService) reserveSeats(ctx context.Context, sagaID string, flightID int64, seats []string) error {
+	for _, seat := range seats {
+		if err := s.flightClient.ReserveSeat(ctx, flightID, seat); err != nil {
+			return fmt.Errorf("seat %s: %w", seat, err)
+		}
+
+		if err := s.repo.RecordSeatReserved(ctx, sagaID, seat); err != nil {
+			// The saga was taken over and will not release this seat.
+			if releaseErr := s.flightClient.ReleaseSeat(ctx, flightID, seat); releaseErr != nil {
+				s.log.Error("failed to release unrecorded seat", "saga_id", sagaID, "seat", seat, "error", releaseErr)
+			}
+			return fmt.Errorf("seat %s: %w", seat, err)
+		}
+	}
+	return nil
+}
+
+// abortSaga turns a saga that is still reserving seats to compensation and
+// runs it. A compensation that fails here is retried by the recovery worker.
+func (s *BookingService) abortSaga(ctx context.Context, sagaID string, cause error) {
+	err := s.repo.UpdateSagaState(ctx, sagaID, domain.SagaReservingSeats, domain.SagaCompensating, cause.Error())
+	if err != nil {
+		s.log.Error("failed to abort saga", "saga_id", sagaID, "error", err)
+		return
+	}
+	s.runSaga(ctx, sagaID)
+}
+
+// resumeBookingSaga runs the step a status change of the booking left due,
+// so seats are confirmed or released without waiting for the recovery worker.
+func (s *BookingService) resumeBookingSaga(ctx context.Context, bookingID string) {
+	saga, err := s.repo.GetSagaByBooking(ctx, bookingID)
+	if err != nil {
+		s.log.Error("failed to get booking saga", "booking_id", bookingID, "error", err)
+		return
+	}
+	if !saga.State.HasPendingStep() {
+		return
+	}
+	s.runSaga(ctx, saga.ID)
+}
+
+func (s *BookingService) runSaga(ctx context.Context, sagaID string) {
+	// The steps are idempotent through the saga's state, they are no effect of
+	// the message that may have started them.
+	ctx = inbox.WithoutMessage(ctx)
+
+	saga, err := s.repo.ClaimSaga(ctx, sagaID, s.cfg.Saga.StepTimeout)
+	if err != nil {
+		if !errors.Is(err, domain.ErrSagaBusy) {
+			s.log.Error("failed to claim saga", "saga_id", sagaID, "error", err)
+		}
+		return
+	}
+
+	if err := s.runSagaStep(ctx, saga); err != nil {
+		s.log.Warn("saga step failed, will retry", "saga_id", sagaID, "state", saga.State, "error", err)
+	}
+}
+
+// RecoverSagas runs up to limit due saga steps: steps that failed before and
+// steps whose replica stopped before finishing them. It returns how many
+// steps were run.
+func (s *BookingService) RecoverSagas(ctx context.Context, limit int) (int, error) {
+	sagas, err := s.repo.ClaimDueSagas(ctx, s.cfg.Saga.StepTimeout, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range sagas {
+		saga := &sagas[i]
+		if err := s.runSagaStep(ctx, saga); err != nil {
+			s.log.Warn("saga step failed, will retry",
+				"saga_id", saga.ID,
+				"state", saga.State,
+				"attempts", saga.Attempts+1,
+				"error", err)
+		}
+	}
+
+	return len(sagas), nil
+}
+
+// runSagaStep runs the step of a claimed saga and moves it to the next
+// state, a failed step is postponed with backoff.
+func (s *BookingService) runSagaStep(ctx context.Context, saga *domain.Saga) error {
+	log := s.log.With("saga_id", saga.ID)
+
+	switch saga.State {
+	case domain.SagaReservingSeats:
+		// The request reserving the seats has not finished in time, its
+		// replica is gone or stuck. Whatever it reserved goes back.
+		err := s.repo.UpdateSagaState(ctx, saga.ID, domain.SagaReservingSeats, domain.SagaCompensating,
+			"abandoned while reserving seats")
+		if err != nil {
+			return err
+		}
+		saga.State = domain.SagaCompensating
+		log.Warn("saga abandoned while reserving seats, compensating", "seats", saga.ReservedSeats)
+	case domain.SagaConfirmingSeats:
+		next, err := s.confirmSagaSeats(ctx, saga)
+		if err != nil {
+			return s.retrySagaStep(ctx, saga, err)
+		}
+		log.Info("saga step done", "state", next)
+		saga.State = next
+	}
+
+	if saga.State != domain.SagaCompensating {
+		return nil
+	}
+
+	if err := s.releaseSagaSeats(ctx, saga); err != nil {
+		return s.retrySagaStep(ctx, saga, err)
+	}
+	if err := s.repo.UpdateSagaState(ctx, saga.ID, domain.SagaCompensating, domain.SagaCompensated, ""); err != nil {
+		return err
+	}
+
+	log.Info("saga step done", "state", domain.SagaCompensated)
+	return nil
+}
+
+func (s *BookingService) retrySagaStep(ctx context.Context, saga *domain.Saga, err error) error {
+	delay := s.cfg.Saga.Backoff(saga.Attempts + 1)
+	if retryErr := s.repo.RetrySagaStep(ctx, saga.ID, delay, err.Error()); retryErr != nil {
+		return errors.Join(err, retryErr)
+	}
+	return err
+}
+
+// confirmSagaSeats confirms the seats of a booking whose payment is
+// authorized, then pays the booking and requests the capture. It returns the
+// state the saga moved to: COMPLETED, or COMPENSATING once the payment is
+// voided because a seat is gone or the booking stopped waiting for it.
+func (s *BookingService) confirmSagaSeats(ctx context.Context, saga *domain.Saga) (domain.SagaState, error) {
+	if saga.BookingID == nil {
+		return "", fmt.Errorf("saga %s has no booking to confirm", saga.ID)
+	}
+
+	booking, err := s.repo.GetByID(ctx, *saga.BookingID)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case booking.IsPaid():
+		// Paid before payments were authorized first, the payment is captured
+		// already.
+		if err := s.flightClient.ConfirmSeats(ctx, saga.FlightID, booking.SeatNumbers()); err != nil {
+			return "", err
+		}
+		return domain.SagaCompleted, s.repo.UpdateSagaState(ctx, saga.ID, domain.SagaConfirmingSeats, domain.SagaCompleted, "")
+	case booking.Status == domain.StatusFlightCancelled:
+		// The seats went away with the flight, there is nothing to release.
+		return s.voidSagaPayment(ctx, booking, saga.ID, domain.SagaCompensated, "flight cancelled")
+	case booking.Status != domain.StatusPending:
+		reason := fmt.Sprintf("booking became %s before its seats were confirmed", booking.Status)
+		return s.voidSagaPayment(ctx, booking, saga.ID, domain.SagaCompensating, reason)
+	}
+
+	err = s.flightClient.ConfirmSeats(ctx, saga.FlightID, booking.SeatNumbers())
+	if errors.Is(err, domain.ErrSeatNotFound) {
+		return s.voidSagaPayment(ctx, booking, saga.ID, domain.SagaCompensating,
+			fmt.Sprintf("seats could not be confirmed: %v", err))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	change := domain.StatusChange{
+		Actor:   domain.ActorSystem,
+		ActorID: saga.ID,
+		Reason:  "seats confirmed, payment capture requested",
+	}
+	// A booking cancelled while its seats were being confirmed fails here with
+	// ErrStatusChanged, the next run voids the payment.
+	if err := s.repo.ConfirmPayment(ctx, booking.ID, saga.ID, s.cfg.TicketAirlineCode, change); err != nil {
+		return "", fmt.Errorf("failed to confirm payment: %w", err)
+	}
+
+	s.log.Info("booking paid, tickets issued and capture requested", "booking_id", booking.ID, "saga_id", saga.ID)
+	return domain.SagaCompleted, nil
+}
+
+// voidSagaPayment requests the void of the booking's authorized payment and
+// moves the saga on to next, failing the booking while it is still pending.
+func (s *BookingService) voidSagaPayment(
+	ctx context.Context,
+	booking *domain.Booking,
+	sagaID string,
+	next domain.SagaState,
+	reason string,
+) (domain.SagaState, error) {
+	change := domain.StatusChange{
+		Actor:   domain.ActorSystem,
+		ActorID: sagaID,
+		Reason:  reason,
+	}
+	if err := s.repo.VoidPayment(ctx, booking, sagaID, next, change); err != nil {
+		return "", fmt.Errorf("failed to void payment: %w", err)
+	}
+
+	s.log.Warn("booking payment voided", "booking_id", booking.ID, "saga_id", sagaID, "reason", reason)
+	return next, nil
+}
+
+// releaseSagaSeats releases the seats the saga holds and gives up the seat
+// changes the booking made while pending, their new seats are released too.
+func (s *BookingService) releaseSagaSeats(ctx context.Context, saga *domain.Saga) error {
+	seats, err := s.sagaSeats(ctx, saga)
+	if err != nil {
+		return err
+	}
+	if err := s.flightClient.ReleaseSeats(ctx, saga.FlightID, seats); err != nil {
+		return err
+	}
+
+	if saga.BookingID == nil {
+		return nil
+	}
+	return s.repo.FailUnpaidSeatChanges(ctx, *saga.BookingID)
+}
+
+// sagaSeats returns the seats the saga holds, the booking's current seats once
+// it is stored since a seat change swaps them, and the new seats of changes
+// still waiting for the booking to be paid.
+func (s *BookingService) sagaSeats(ctx context.Context, saga *domain.Saga) ([]string, error) {
+	if saga.BookingID == nil {
+		return saga.ReservedSeats, nil
+	}
+
+	booking, err := s.repo.GetByID(ctx, *saga.BookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.repo.ListSeatChanges(ctx, booking.ID, domain.SeatChangeAwaitingPayment)
+	if err != nil {
+		return nil, err
+	}
+
+	seats := booking.SeatNumbers()
+	for _, sc := range changes {
+		seats = append(seats, sc.NewSeatNumber)
+	}
+	return seats, nil
+}
+
+// ListSagas returns the booking sagas matching the filter, newest first.
+func (s *BookingService) ListSagas(ctx context.Context, caller domain.Caller, filter domain.SagaFilter) ([]domain.Saga, error) {
+	if !caller.IsAdmin() {
+		return nil, domain.ErrAdminOnly
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultSagasLimit
+	}
+	if filter.Limit > MaxSagasLimit {
+		filter.Limit = MaxSagasLimit
+	}
+
+	return s.repo.ListSagas(ctx, filter)
+}
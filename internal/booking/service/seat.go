@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/booking/domain"
+	"github.com/squ1ky/flyte/internal/booking/domain/events"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"log/slog"
+	"strconv"
+)
+
+type ChangeSeatDTO struct {
+	BookingID     string
+	Caller        domain.Caller
+	OldSeatNumber string
+	NewSeatNumber string
+}
+
+func (s *BookingService) ChangeSeat(ctx context.Context, dto ChangeSeatDTO) (*domain.Booking, *domain.SeatChange, error) {
+	log := s.log.With(
+		"booking_id", dto.BookingID,
+		"caller_id", dto.Caller.UserID,
+		"old_seat", dto.OldSeatNumber,
+		"new_seat", dto.NewSeatNumber)
+
+	booking, err := s.getOwnedBooking(ctx, dto.BookingID, dto.Caller)
+	if err != nil {
+		log.Error("failed to fetch booking", "error", err)
+		return nil, nil, fmt.Errorf("failed to fetch booking: %w", err)
+	}
+
+	if booking.Status != domain.StatusPending && booking.Status != domain.StatusPaid {
+		log.Warn("cannot change seat", "status", booking.Status)
+		return nil, nil, fmt.Errorf("status %s: %w", booking.Status, domain.ErrSeatNotChangeable)
+	}
+
+	item, ok := booking.Item(dto.OldSeatNumber)
+	if !ok {
+		return nil, nil, fmt.Errorf("seat %s: %w", dto.OldSeatNumber, domain.ErrSeatNotInBooking)
+	}
+
+	quote, err := s.QuoteBooking(ctx, booking.FlightID, []string{dto.NewSeatNumber})
+	if err != nil {
+		log.Warn("failed to quote new seat", "error", err)
+		return nil, nil, fmt.Errorf("failed to quote new seat: %w", err)
+	}
+
+	seatChange := &domain.SeatChange{
+		BookingID:     booking.ID,
+		BookingItemID: item.ID,
+		OldSeatNumber: item.SeatNumber,
+		NewSeatNumber: dto.NewSeatNumber,
+		OldPriceCents: item.PriceCents,
+		NewPriceCents: quote.TotalCents,
+	}
+
+	if err := s.flightClient.ReserveSeat(ctx, booking.FlightID, dto.NewSeatNumber); err != nil {
+		log.Error("failed to reserve new seat", "error", err)
+		return nil, nil, fmt.Errorf("failed to reserve new seat: %w", err)
+	}
+
+	change := domain.StatusChange{
+		Actor:   domain.ActorUser,
+		ActorID: strconv.FormatInt(dto.Caller.UserID, 10),
+		Reason: fmt.Sprintf("seat changed from %s to %s, price difference %d",
+			seatChange.OldSeatNumber, seatChange.NewSeatNumber, seatChange.PriceDifferenceCents()),
+	}
+
+	if err := s.repo.ChangeSeat(ctx, booking, seatChange, change); err != nil {
+		log.Error("failed to save seat change, releasing new seat", "error", err)
+		if releaseErr := s.flightClient.ReleaseSeat(ctx, booking.FlightID, dto.NewSeatNumber); releaseErr != nil {
+			log.Error("failed to release new seat during rollback", "error", releaseErr)
+		}
+		return nil, nil, fmt.Errorf("failed to change seat: %w", err)
+	}
+
+	if seatChange.Status == domain.SeatChangeAwaitingPayment {
+		// Both seats stay held until the surcharge result settles the change,
+		// a pending booking's surcharge is requested once it is paid.
+		log.Info("seat change awaiting surcharge", "price_difference", seatChange.PriceDifferenceCents())
+	} else {
+		if booking.Status == domain.StatusPaid {
+			if err := s.flightClient.ConfirmSeat(ctx, booking.FlightID, dto.NewSeatNumber); err != nil {
+				log.Error("seat changed but failed to confirm new seat", "error", err)
+			}
+		}
+
+		if err := s.flightClient.ReleaseSeat(ctx, booking.FlightID, dto.OldSeatNumber); err != nil {
+			log.Error("seat changed but failed to release old seat", "error", err)
+		}
+
+		log.Info("seat changed", "price_difference", seatChange.PriceDifferenceCents())
+	}
+
+	updated, err := s.repo.GetByID(ctx, booking.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch updated booking: %w", err)
+	}
+
+	return updated, seatChange, nil
+}
+
+// processSurchargeResult settles a seat change awaiting its surcharge. A paid
+// surcharge moves the passenger to the new seat, unless the booking is no
+// longer paid or the new seat was lost meanwhile; the surcharge is refunded
+// then. A failed surcharge keeps the passenger on the old seat.
+func (s *BookingService) processSurchargeResult(ctx context.Context, booking *domain.Booking, res events.PaymentResultEvent) error {
+	log := s.log.With("booking_id", booking.ID, "seat_change_id", res.Reference, "status", res.Status)
+
+	sc, err := s.repo.GetSeatChange(ctx, res.Reference)
+	if err != nil {
+		if errors.Is(err, domain.ErrSeatChangeNotFound) {
+			log.Error("seat change not found for surcharge result")
+			return nil
+		}
+		return fmt.Errorf("failed to get seat change: %w", err)
+	}
+	if sc.Status != domain.SeatChangeAwaitingPayment {
+		log.Info("seat change already settled", "seat_change_status", sc.Status)
+		return nil
+	}
+
+	if res.Status != events.PaymentStatusSuccess {
+		log.Warn("surcharge failed, keeping old seat", "reason", res.ErrorMessage)
+		return s.failSeatChange(ctx, log, booking, sc, false)
+	}
+
+	if booking.Status != domain.StatusPaid {
+		log.Warn("surcharge paid after booking left PAID, refunding it", "current_status", booking.Status)
+		return s.failSeatChange(ctx, log, booking, sc, true)
+	}
+
+	if err := s.flightClient.ConfirmSeat(ctx, booking.FlightID, sc.NewSeatNumber); err != nil {
+		if !errors.Is(err, domain.ErrSeatNotFound) {
+			return fmt.Errorf("failed to confirm new seat: %w", err)
+		}
+		log.Warn("new seat reservation lapsed, refunding surcharge")
+		return s.failSeatChange(ctx, log, booking, sc, true)
+	}
+
+	change := domain.StatusChange{
+		Actor:   domain.ActorPaymentEvent,
+		ActorID: res.PaymentID,
+		Reason: fmt.Sprintf("seat changed from %s to %s, price difference %d",
+			sc.OldSeatNumber, sc.NewSeatNumber, sc.PriceDifferenceCents()),
+	}
+	err = s.repo.ApplySeatChange(ctx, booking, sc, change)
+	switch {
+	case errors.Is(err, inbox.ErrDuplicate):
+		return err
+	case errors.Is(err, domain.ErrStatusChanged), errors.Is(err, domain.ErrSeatNotInBooking):
+		log.Warn("seat change no longer applies, refunding surcharge", "error", err)
+		return s.failSeatChange(ctx, log, booking, sc, true)
+	case err != nil:
+		return fmt.Errorf("failed to apply seat change: %w", err)
+	}
+
+	if err := s.flightClient.ReleaseSeat(ctx, booking.FlightID, sc.OldSeatNumber); err != nil {
+		log.Error("seat changed but failed to release old seat", "error", err)
+	}
+
+	log.Info("surcharge paid, seat changed", "price_difference", sc.PriceDifferenceCents())
+	return nil
+}
+
+// failSeatChange settles a seat change as failed and gives the new seat back.
+func (s *BookingService) failSeatChange(ctx context.Context, log *slog.Logger, booking *domain.Booking, sc *domain.SeatChange, refund bool) error {
+	err := s.repo.FailSeatChange(ctx, booking, sc, refund)
+	if errors.Is(err, domain.ErrSeatChangeSettled) {
+		log.Info("seat change already settled")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fail seat change: %w", err)
+	}
+
+	if err := s.flightClient.ReleaseSeat(ctx, booking.FlightID, sc.NewSeatNumber); err != nil {
+		log.Error("failed to release seat of failed seat change", "error", err)
+	}
+
+	return nil
+}
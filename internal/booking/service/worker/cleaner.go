@@ -3,7 +3,6 @@ package worker
 import (
 	"context"
 	"fmt"
-	"github.com/squ1ky/flyte/internal/booking/clients/grpc/flight"
 	"github.com/squ1ky/flyte/internal/booking/domain"
 	"github.com/squ1ky/flyte/internal/booking/repository"
 	"log/slog"
@@ -11,26 +10,23 @@ import (
 )
 
 type ExpiredBookingCleaner struct {
-	repo         repository.BookingRepository
-	flightClient *flight.Client
-	log          *slog.Logger
-	interval     time.Duration
-	bookingTTL   time.Duration
+	repo       repository.BookingRepository
+	log        *slog.Logger
+	interval   time.Duration
+	bookingTTL time.Duration
 }
 
 func NewExpiredBookingCleaner(
 	repo repository.BookingRepository,
-	flightClient *flight.Client,
 	log *slog.Logger,
 	interval time.Duration,
 	bookingTTL time.Duration,
 ) *ExpiredBookingCleaner {
 	return &ExpiredBookingCleaner{
-		repo:         repo,
-		flightClient: flightClient,
-		log:          log,
-		interval:     interval,
-		bookingTTL:   bookingTTL,
+		repo:       repo,
+		log:        log,
+		interval:   interval,
+		bookingTTL: bookingTTL,
 	}
 }
 
@@ -69,16 +65,17 @@ func (c *ExpiredBookingCleaner) process(ctx context.Context) error {
 
 	for _, b := range bookings {
 		log := c.log.With("booking_id", b.ID)
-		if err := c.repo.UpdateStatus(ctx, b.ID, domain.StatusTimeout); err != nil {
+		change := domain.StatusChange{
+			Actor:  domain.ActorCleanerWorker,
+			Reason: fmt.Sprintf("not paid within %s", c.bookingTTL),
+		}
+		if err := c.repo.UpdateStatus(ctx, b.ID, domain.StatusPending, domain.StatusTimeout, change); err != nil {
 			log.Error("failed to update booking status to TIMEOUT", "error", err)
 			continue
 		}
 
-		if err := c.flightClient.ReleaseSeat(ctx, b.FlightID, b.SeatNumber); err != nil {
-			log.Warn("failed to release seat in flight service", "error", err)
-		} else {
-			log.Info("booking expired and seat released successfully")
-		}
+		// The booking saga releases the seats, see SagaRecoverer.
+		log.Info("booking expired")
 	}
 
 	return nil
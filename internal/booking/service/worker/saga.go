@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// SagaRunner runs the due steps of booking sagas.
+type SagaRunner interface {
+	RecoverSagas(ctx context.Context, limit int) (int, error)
+}
+
+// SagaRecoverer runs the saga steps nobody else finishes: retries of failed
+// steps and steps left behind by a replica that stopped.
+type SagaRecoverer struct {
+	sagas     SagaRunner
+	log       *slog.Logger
+	interval  time.Duration
+	batchSize int
+}
+
+func NewSagaRecoverer(sagas SagaRunner, log *slog.Logger, interval time.Duration, batchSize int) *SagaRecoverer {
+	return &SagaRecoverer{
+		sagas:     sagas,
+		log:       log,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (r *SagaRecoverer) Start(ctx context.Context) {
+	r.log.Info("starting saga recovery worker", "interval", r.interval)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.log.Info("stopping saga recovery worker")
+			return
+		case <-ticker.C:
+			// Drain the backlog before waiting for the next tick.
+			for {
+				n, err := r.sagas.RecoverSagas(ctx, r.batchSize)
+				if err != nil {
+					r.log.Error("failed to recover sagas", "error", err)
+					break
+				}
+				if n < r.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
@@ -12,6 +12,9 @@ type Config struct {
 	DB      DBConfig
 	Elastic ElasticConfig
 	Cleaner CleanerConfig
+	Kafka   KafkaConfig
+	Outbox  OutboxConfig
+	Leader  LeaderConfig
 }
 
 type GRPCConfig struct {
@@ -37,6 +40,25 @@ type CleanerConfig struct {
 	ReservationTTL time.Duration `env:"RESERVATION_TTL" env-default:"15m"`
 }
 
+type KafkaConfig struct {
+	Brokers     []string `env:"KAFKA_BROKERS" env-default:"localhost:9092"`
+	TopicEvents string   `env:"KAFKA_TOPIC_FLIGHT_EVENTS" env-default:"flight_events"`
+}
+
+type OutboxConfig struct {
+	Interval    time.Duration `env:"FLIGHT_OUTBOX_INTERVAL" env-default:"1s"`
+	BatchSize   int           `env:"FLIGHT_OUTBOX_BATCH_SIZE" env-default:"50"`
+	MaxAttempts int           `env:"FLIGHT_OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+	BaseBackoff time.Duration `env:"FLIGHT_OUTBOX_BASE_BACKOFF" env-default:"1s"`
+	MaxBackoff  time.Duration `env:"FLIGHT_OUTBOX_MAX_BACKOFF" env-default:"10m"`
+}
+
+// LeaderConfig controls the election of the replica running the background
+// workers.
+type LeaderConfig struct {
+	Interval time.Duration `env:"FLIGHT_LEADER_INTERVAL" env-default:"5s"`
+}
+
 func Load() (*Config, error) {
 	var cfg Config
 
@@ -5,6 +5,8 @@ import "errors"
 var (
 	ErrFlightNotFound      = errors.New("flight not found")
 	ErrFlightAlreadyExists = errors.New("flight already exists")
+	ErrFlightNotCancelable = errors.New("flight cannot be cancelled in its current status")
+	ErrFlightCancelled     = errors.New("flight is cancelled")
 
 	ErrSeatNotFound      = errors.New("seat not found")
 	ErrSeatAlreadyBooked = errors.New("seat already booked")
@@ -1,8 +1,11 @@
 package domain
 
+import "github.com/squ1ky/flyte/pkg/eventcodec"
+
 type EventType string
 
 const (
-	EventFlightCreated EventType = "FLIGHT_CREATED"
-	EventSeatsChanged  EventType = "SEATS_CHANGED"
+	EventFlightCreated   EventType = "FLIGHT_CREATED"
+	EventSeatsChanged    EventType = "SEATS_CHANGED"
+	EventFlightCancelled EventType = eventcodec.EventTypeFlightCancelled
 )
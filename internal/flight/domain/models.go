@@ -57,6 +57,10 @@ type Flight struct {
 
 	AvailableSeats int    `db:"available_seats" json:"available_seats"`
 	Seats          []Seat `db:"-" json:"seats,omitempty"`
+
+	// DepartureTimezone is the departure airport's time zone, loaded with the
+	// flight's details only.
+	DepartureTimezone string `db:"departure_timezone" json:"departure_timezone,omitempty"`
 }
 
 type Seat struct {
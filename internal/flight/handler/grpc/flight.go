@@ -5,9 +5,11 @@ import (
 	"errors"
 	flightv1 "github.com/squ1ky/flyte/gen/go/flight"
 	"github.com/squ1ky/flyte/internal/flight/domain"
+	"github.com/squ1ky/flyte/internal/flight/repository"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 )
 
 func (s *Server) SearchFlights(ctx context.Context, req *flightv1.SearchFlightsRequest) (*flightv1.SearchFlightsResponse, error) {
@@ -122,6 +124,47 @@ func (s *Server) ListAirports(ctx context.Context, req *flightv1.ListAirportsReq
 	return &flightv1.ListAirportsResponse{Airports: pbAirports}, nil
 }
 
+func (s *Server) CancelFlight(ctx context.Context, req *flightv1.CancelFlightRequest) (*flightv1.CancelFlightResponse, error) {
+	if err := validateCancelFlightRequest(req); err != nil {
+		return nil, err
+	}
+
+	flight, err := s.flightService.CancelFlight(ctx, req.FlightId, strings.TrimSpace(req.Reason))
+	if err != nil {
+		if errors.Is(err, domain.ErrFlightNotFound) {
+			return nil, status.Error(codes.NotFound, domain.ErrFlightNotFound.Error())
+		}
+		if errors.Is(err, domain.ErrFlightNotCancelable) {
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrFlightNotCancelable.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel flight: %v", err)
+	}
+
+	return &flightv1.CancelFlightResponse{Flight: mapFlightToProto(flight)}, nil
+}
+
+func (s *Server) FindNextFlight(ctx context.Context, req *flightv1.FindNextFlightRequest) (*flightv1.FindNextFlightResponse, error) {
+	if err := validateFindNextFlightRequest(req); err != nil {
+		return nil, err
+	}
+
+	flight, err := s.flightService.FindNextFlight(ctx, repository.NextFlightFilter{
+		FromAirport:     req.FromAirport,
+		ToAirport:       req.ToAirport,
+		DepartureAfter:  req.DepartureAfter.AsTime(),
+		PassengerCount:  int(req.PassengerCount),
+		ExcludeFlightID: req.ExcludeFlightId,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrFlightNotFound) {
+			return nil, status.Error(codes.NotFound, domain.ErrFlightNotFound.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find next flight: %v", err)
+	}
+
+	return &flightv1.FindNextFlightResponse{Flight: mapFlightToProto(flight)}, nil
+}
+
 func (s *Server) ReserveSeat(ctx context.Context, req *flightv1.ReserveSeatRequest) (*flightv1.ReserveSeatResponse, error) {
 	if err := validateReserveSeatRequest(req); err != nil {
 		return nil, err
@@ -135,6 +178,9 @@ func (s *Server) ReserveSeat(ctx context.Context, req *flightv1.ReserveSeatReque
 		if errors.Is(err, domain.ErrSeatNotFound) {
 			return nil, status.Error(codes.NotFound, domain.ErrSeatNotFound.Error())
 		}
+		if errors.Is(err, domain.ErrFlightCancelled) {
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrFlightCancelled.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to reserve seat: %v", err)
 	}
 
@@ -178,5 +224,7 @@ func mapFlightToProto(f *domain.Flight) *flightv1.Flight {
 		BasePriceCents:   f.BasePriceCents,
 		Status:           string(f.Status),
 		AvailableSeats:   int32(f.AvailableSeats),
+
+		DepartureTimezone: f.DepartureTimezone,
 	}
 }
@@ -18,6 +18,8 @@ var (
 	errInvalidPrice         = errors.New("price must be positive")
 	errInvalidPassenger     = errors.New("passenger count must be positive")
 
+	errDepartureAfterRequired = errors.New("departure_after is required")
+
 	errAircraftIDRequired    = errors.New("aircraft ID is required")
 	errAircraftModelRequired = errors.New("aircraft model is required")
 	errTotalSeatsInvalid     = errors.New("total seats must be positive")
@@ -49,6 +51,26 @@ func validateCreateFlightRequest(req *flightv1.CreateFlightRequest) error {
 	return nil
 }
 
+func validateCancelFlightRequest(req *flightv1.CancelFlightRequest) error {
+	if req.FlightId <= 0 {
+		return status.Error(codes.InvalidArgument, errFlightIDRequired.Error())
+	}
+	return nil
+}
+
+func validateFindNextFlightRequest(req *flightv1.FindNextFlightRequest) error {
+	if strings.TrimSpace(req.FromAirport) == "" || strings.TrimSpace(req.ToAirport) == "" {
+		return status.Error(codes.InvalidArgument, errAirportsRequired.Error())
+	}
+	if req.DepartureAfter == nil {
+		return status.Error(codes.InvalidArgument, errDepartureAfterRequired.Error())
+	}
+	if req.PassengerCount <= 0 {
+		return status.Error(codes.InvalidArgument, errInvalidPassenger.Error())
+	}
+	return nil
+}
+
 func validateSearchFlightsRequest(req *flightv1.SearchFlightsRequest) error {
 	if strings.TrimSpace(req.FromAirport) == "" || strings.TrimSpace(req.ToAirport) == "" {
 		return status.Error(codes.InvalidArgument, errAirportsRequired.Error())
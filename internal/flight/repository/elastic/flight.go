@@ -9,6 +9,7 @@ import (
 	"github.com/squ1ky/flyte/internal/flight/domain"
 	"github.com/squ1ky/flyte/internal/flight/repository"
 	"io"
+	"net/http"
 	"time"
 )
 
@@ -103,6 +104,25 @@ func (r *FlightSearchRepo) UpdateAvailableSeats(ctx context.Context, flightID in
 	return nil
 }
 
+// RemoveFlight drops a flight from the search index, a missing document is
+// not an error.
+func (r *FlightSearchRepo) RemoveFlight(ctx context.Context, flightID int64) error {
+	res, err := r.client.Delete(
+		indexName,
+		fmt.Sprintf("%d", flightID),
+		r.client.Delete.WithContext(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("elastic delete request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("elastic delete response error: %s", res.String())
+	}
+	return nil
+}
+
 func (r *FlightSearchRepo) Search(ctx context.Context, filter repository.SearchFilter) ([]domain.Flight, error) {
 	query := r.buildSearchQuery(filter)
 
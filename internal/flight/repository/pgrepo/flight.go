@@ -3,24 +3,31 @@ package pgrepo
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
 	"github.com/squ1ky/flyte/internal/flight/domain"
+	"github.com/squ1ky/flyte/internal/flight/repository"
+	"github.com/squ1ky/flyte/pkg/outbox"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	pgErrUniqueViolation = "23505"
 )
 
+// OutboxTable holds the events the outbox relay publishes.
+const OutboxTable = "flight_outbox"
+
 type FlightRepo struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	outbox *outbox.Writer
 }
 
 func NewFlightRepo(db *sqlx.DB) *FlightRepo {
-	return &FlightRepo{db: db}
+	return &FlightRepo{db: db, outbox: outbox.NewWriter(OutboxTable)}
 }
 
 func (r *FlightRepo) CreateFlight(ctx context.Context, f *domain.Flight) (int64, error) {
@@ -82,8 +89,10 @@ func (r *FlightRepo) GetByID(ctx context.Context, id int64) (*domain.Flight, err
 		SELECT f.*,
 		       (SELECT COUNT(*)
 		        FROM seats s
-		        WHERE s.flight_id = f.id AND s.is_booked = FALSE) as available_seats
+		        WHERE s.flight_id = f.id AND s.is_booked = FALSE) as available_seats,
+		       a.timezone as departure_timezone
 		FROM flights f
+		JOIN airports a ON a.code = f.departure_airport
 		WHERE f.id = $1
 	`
 
@@ -111,6 +120,93 @@ func (r *FlightRepo) DeleteFlight(ctx context.Context, id int64) error {
 	return nil
 }
 
+// CancelFlight marks a scheduled or delayed flight as cancelled and records a
+// FLIGHT_CANCELLED outbox event in the same transaction.
+func (r *FlightRepo) CancelFlight(ctx context.Context, id int64, reason string) (*domain.Flight, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	queryUpdate := `
+		UPDATE flights
+		SET status = $2
+		WHERE id = $1 AND status IN ($3, $4)
+		RETURNING *
+	`
+
+	var flight domain.Flight
+	err = tx.GetContext(ctx, &flight, queryUpdate, id, domain.FlightStatusCancelled,
+		domain.FlightStatusScheduled, domain.FlightStatusDelayed)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("cancel flight: %w", err)
+		}
+
+		var exists bool
+		if err := tx.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM flights WHERE id = $1)", id); err != nil {
+			return nil, fmt.Errorf("check flight exists: %w", err)
+		}
+		if !exists {
+			return nil, domain.ErrFlightNotFound
+		}
+		return nil, domain.ErrFlightNotCancelable
+	}
+
+	event := &eventsv1.FlightCancelled{
+		FlightId:         flight.ID,
+		FlightNumber:     flight.FlightNumber,
+		DepartureAirport: flight.DepartureAirport,
+		ArrivalAirport:   flight.ArrivalAirport,
+		DepartureTime:    timestamppb.New(flight.DepartureTime),
+		Reason:           reason,
+		CancelledAt:      timestamppb.Now(),
+	}
+	if err := r.insertOutboxEvent(ctx, tx, domain.EventFlightCancelled, event); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return &flight, nil
+}
+
+func (r *FlightRepo) FindNextFlight(ctx context.Context, f repository.NextFlightFilter) (*domain.Flight, error) {
+	query := `
+		SELECT *
+		FROM (SELECT f.*,
+		             (SELECT COUNT(*)
+		              FROM seats s
+		              WHERE s.flight_id = f.id AND s.is_booked = FALSE) as available_seats,
+		             a.timezone as departure_timezone
+		      FROM flights f
+		      JOIN airports a ON a.code = f.departure_airport
+		      WHERE f.departure_airport = $1
+		        AND f.arrival_airport = $2
+		        AND f.departure_time > $3
+		        AND f.id <> $4
+		        AND f.status IN ($5, $6)) candidates
+		WHERE available_seats >= $7
+		ORDER BY departure_time
+		LIMIT 1
+	`
+
+	var flight domain.Flight
+	err := r.db.GetContext(ctx, &flight, query, f.FromAirport, f.ToAirport, f.DepartureAfter, f.ExcludeFlightID,
+		domain.FlightStatusScheduled, domain.FlightStatusDelayed, f.PassengerCount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrFlightNotFound
+		}
+		return nil, fmt.Errorf("find next flight: %w", err)
+	}
+
+	return &flight, nil
+}
+
 func (r *FlightRepo) GetSeatsByFlightID(ctx context.Context, flightID int64) ([]domain.Seat, error) {
 	queryCheck := "SELECT EXISTS(SELECT 1 FROM flights WHERE id = $1)"
 	var exists bool
@@ -137,15 +233,17 @@ func (r *FlightRepo) BookSeat(ctx context.Context, flightID int64, seatNumber st
 	defer tx.Rollback()
 
 	queryLock := `
-		SELECT id, is_booked
-		FROM seats
-		WHERE flight_id = $1 AND seat_number = $2
-		FOR UPDATE
+		SELECT s.id, s.is_booked, f.status
+		FROM seats s
+		JOIN flights f ON f.id = s.flight_id
+		WHERE s.flight_id = $1 AND s.seat_number = $2
+		FOR UPDATE OF s
 	`
 
 	var seat struct {
-		ID       int64 `db:"id"`
-		IsBooked bool  `db:"is_booked"`
+		ID           int64               `db:"id"`
+		IsBooked     bool                `db:"is_booked"`
+		FlightStatus domain.FlightStatus `db:"status"`
 	}
 
 	if err := tx.GetContext(ctx, &seat, queryLock, flightID, seatNumber); err != nil {
@@ -154,6 +252,9 @@ func (r *FlightRepo) BookSeat(ctx context.Context, flightID int64, seatNumber st
 		}
 		return 0, fmt.Errorf("fetch seat: %w", err)
 	}
+	if seat.FlightStatus == domain.FlightStatusCancelled {
+		return 0, domain.ErrFlightCancelled
+	}
 	if seat.IsBooked {
 		return 0, domain.ErrSeatAlreadyBooked
 	}
@@ -246,18 +347,5 @@ func (r *FlightRepo) GetAirports(ctx context.Context) ([]domain.Airport, error)
 }
 
 func (r *FlightRepo) insertOutboxEvent(ctx context.Context, tx *sqlx.Tx, eventType domain.EventType, payload interface{}) error {
-	data, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("marshal outbox payload: %w", err)
-	}
-
-	query := `
-		INSERT INTO flight_outbox (event_type, payload)
-		VALUES ($1, $2)
-	`
-
-	if _, err := tx.ExecContext(ctx, query, eventType, data); err != nil {
-		return fmt.Errorf("insert outbox event: %w", err)
-	}
-	return nil
+	return r.outbox.Write(ctx, tx, string(eventType), payload)
 }
@@ -13,10 +13,22 @@ type SearchFilter struct {
 	PassengerCount int
 }
 
+// NextFlightFilter selects the earliest flight on a route departing after
+// DepartureAfter that can still seat PassengerCount passengers.
+type NextFlightFilter struct {
+	FromAirport     string
+	ToAirport       string
+	DepartureAfter  time.Time
+	PassengerCount  int
+	ExcludeFlightID int64
+}
+
 type FlightStorage interface {
 	CreateFlight(ctx context.Context, flight *domain.Flight) (int64, error)
 	GetByID(ctx context.Context, id int64) (*domain.Flight, error)
 	DeleteFlight(ctx context.Context, id int64) error
+	CancelFlight(ctx context.Context, id int64, reason string) (*domain.Flight, error)
+	FindNextFlight(ctx context.Context, filter NextFlightFilter) (*domain.Flight, error)
 
 	GetSeatsByFlightID(ctx context.Context, flightID int64) ([]domain.Seat, error)
 	BookSeat(ctx context.Context, flightID int64, seatNumber string) (int64, error)
@@ -39,4 +51,5 @@ type FlightSearcher interface {
 	Search(ctx context.Context, filter SearchFilter) ([]domain.Flight, error)
 	IndexFlight(ctx context.Context, flight *domain.Flight) error
 	UpdateAvailableSeats(ctx context.Context, flightID int64, newCount int) error
+	RemoveFlight(ctx context.Context, flightID int64) error
 }
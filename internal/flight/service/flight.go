@@ -36,6 +36,37 @@ func (s *FlightService) CreateFlight(ctx context.Context, f *domain.Flight) (int
 	return id, nil
 }
 
+// CancelFlight cancels the flight, the FLIGHT_CANCELLED event is published
+// from the outbox.
+func (s *FlightService) CancelFlight(ctx context.Context, flightID int64, reason string) (*domain.Flight, error) {
+	flight, err := s.flightStorage.CancelFlight(ctx, flightID, reason)
+	if err != nil {
+		if errors.Is(err, domain.ErrFlightNotFound) || errors.Is(err, domain.ErrFlightNotCancelable) {
+			return nil, err
+		}
+		s.logger.Error("failed to cancel flight", "flight_id", flightID, "error", err)
+		return nil, fmt.Errorf("cancel flight failed: %w", err)
+	}
+
+	s.logger.Info("flight cancelled", "flight_id", flightID, "reason", reason)
+	return flight, nil
+}
+
+func (s *FlightService) FindNextFlight(ctx context.Context, filter repository.NextFlightFilter) (*domain.Flight, error) {
+	flight, err := s.flightStorage.FindNextFlight(ctx, filter)
+	if err != nil {
+		if errors.Is(err, domain.ErrFlightNotFound) {
+			return nil, err
+		}
+		s.logger.Error("failed to find next flight",
+			"from", filter.FromAirport,
+			"to", filter.ToAirport,
+			"error", err)
+		return nil, fmt.Errorf("find next flight failed: %w", err)
+	}
+	return flight, nil
+}
+
 func (s *FlightService) SearchFlights(ctx context.Context, from, to string, date time.Time, passengerCount int) ([]domain.Flight, error) {
 	filter := repository.SearchFilter{
 		FromAirport:    from,
@@ -2,16 +2,17 @@ package worker
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	"github.com/squ1ky/flyte/internal/flight/domain"
+	"github.com/squ1ky/flyte/pkg/outbox"
 	"log/slog"
 	"time"
 )
 
 type SeatCleaner struct {
 	db             *sqlx.DB
+	outbox         *outbox.Writer
 	logger         *slog.Logger
 	interval       time.Duration
 	reservationTTL time.Duration
@@ -19,12 +20,14 @@ type SeatCleaner struct {
 
 func NewSeatCleaner(
 	db *sqlx.DB,
+	outbox *outbox.Writer,
 	logger *slog.Logger,
 	interval time.Duration,
 	reservationTTL time.Duration,
 ) *SeatCleaner {
 	return &SeatCleaner{
 		db:             db,
+		outbox:         outbox,
 		logger:         logger,
 		interval:       interval,
 		reservationTTL: reservationTTL,
@@ -66,7 +69,7 @@ func (c *SeatCleaner) processExpiredReservations(ctx context.Context) error {
 		  AND reserved_at < $1
 		RETURNING flight_id
 	`
-	rows, err := c.db.QueryContext(ctx, queryUpdate, cutoffTime)
+	rows, err := tx.QueryContext(ctx, queryUpdate, cutoffTime)
 	if err != nil {
 		return fmt.Errorf("update expired seats: %w", err)
 	}
@@ -87,19 +90,11 @@ func (c *SeatCleaner) processExpiredReservations(ctx context.Context) error {
 		return nil
 	}
 
-	stmtOutbox, err := tx.PrepareContext(ctx, "INSERT INTO flight_outbox (event_type, payload) VALUES ($1, $2)")
-	if err != nil {
-		return fmt.Errorf("prepare outbox stmt: %w", err)
-	}
-	defer stmtOutbox.Close()
-
 	for flightID := range affectedFlightIDs {
 		payload := map[string]int64{
 			"flight_id": flightID,
 		}
-		data, _ := json.Marshal(payload)
-
-		if _, err := stmtOutbox.Exec(ctx, domain.EventSeatsChanged, data); err != nil {
+		if err := c.outbox.Write(ctx, tx, string(domain.EventSeatsChanged), payload); err != nil {
 			return fmt.Errorf("insert outbox event for flight %d: %w", flightID, err)
 		}
 	}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/internal/flight/domain"
+	"github.com/squ1ky/flyte/internal/flight/repository"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/outbox"
+	"strconv"
+)
+
+// ElasticSink keeps the search index in sync with the flights table. It is
+// driven by the outbox relay, every handled event is idempotent.
+type ElasticSink struct {
+	flightRepo     repository.FlightStorage
+	flightSearcher repository.FlightSearcher
+}
+
+func NewElasticSink(flightRepo repository.FlightStorage, searcher repository.FlightSearcher) *ElasticSink {
+	return &ElasticSink{
+		flightRepo:     flightRepo,
+		flightSearcher: searcher,
+	}
+}
+
+func (s *ElasticSink) Publish(ctx context.Context, event outbox.Event) error {
+	switch domain.EventType(event.EventType) {
+	case domain.EventFlightCreated:
+		var flight domain.Flight
+		if err := json.Unmarshal(event.Payload, &flight); err != nil {
+			return outbox.Permanent(fmt.Errorf("unmarshal flight: %w", err))
+		}
+		return s.flightSearcher.IndexFlight(ctx, &flight)
+	case domain.EventSeatsChanged:
+		var eventData struct {
+			FlightID int64 `json:"flight_id"`
+		}
+		if err := json.Unmarshal(event.Payload, &eventData); err != nil {
+			return outbox.Permanent(fmt.Errorf("unmarshal seats event: %w", err))
+		}
+
+		flight, err := s.flightRepo.GetByID(ctx, eventData.FlightID)
+		if err != nil {
+			return fmt.Errorf("get fresh flight data: %w", err)
+		}
+		return s.flightSearcher.UpdateAvailableSeats(ctx, flight.ID, flight.AvailableSeats)
+	case domain.EventFlightCancelled:
+		var cancelled eventsv1.FlightCancelled
+		if err := eventcodec.UnmarshalJSON(event.Payload, &cancelled); err != nil {
+			return outbox.Permanent(err)
+		}
+
+		if err := s.flightSearcher.RemoveFlight(ctx, cancelled.FlightId); err != nil {
+			return fmt.Errorf("remove cancelled flight from index: %w", err)
+		}
+		return nil
+	default:
+		return outbox.Permanent(fmt.Errorf("unknown event type: %s", event.EventType))
+	}
+}
+
+// FlightKey keys flight events by flight so that all events of one flight
+// land in the same partition.
+func FlightKey(event outbox.Event) ([]byte, error) {
+	var cancelled eventsv1.FlightCancelled
+	if err := eventcodec.UnmarshalJSON(event.Payload, &cancelled); err != nil {
+		return nil, err
+	}
+	if cancelled.FlightId == 0 {
+		return nil, fmt.Errorf("event has no flight id")
+	}
+	return []byte(strconv.FormatInt(cancelled.FlightId, 10)), nil
+}
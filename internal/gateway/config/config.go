@@ -19,6 +19,7 @@ type ClientsConfig struct {
 	UserAddr    string `env:"USER_SERVICE_ADDR" env-required:"true"`
 	FlightAddr  string `env:"FLIGHT_SERVICE_ADDR" env-required:"true"`
 	BookingAddr string `env:"BOOKING_SERVICE_ADDR" env-required:"true"`
+	PaymentAddr string `env:"PAYMENT_SERVICE_ADDR" env-required:"true"`
 }
 
 func Load() (*Config, error) {
@@ -3,9 +3,14 @@ package handler
 import (
 	"github.com/gin-gonic/gin"
 	bookingv1 "github.com/squ1ky/flyte/gen/go/booking"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"net/http"
+	"strings"
+	"time"
 )
 
+const idempotencyKeyHeader = "Idempotency-Key"
+
 type BookingHandler struct {
 	client bookingv1.BookingServiceClient
 }
@@ -16,13 +21,41 @@ func NewBookingHandler(client bookingv1.BookingServiceClient) *BookingHandler {
 	}
 }
 
+type quoteBookingInput struct {
+	FlightId    int64    `json:"flight_id" binding:"required,gt=0"`
+	SeatNumbers []string `json:"seat_numbers" binding:"required,min=1,dive,required"`
+}
+
+func (h *BookingHandler) QuoteBooking(c *gin.Context) {
+	var inp quoteBookingInput
+	if err := c.ShouldBindJSON(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.client.GetBookingQuote(c.Request.Context(), &bookingv1.GetBookingQuoteRequest{
+		FlightId:    inp.FlightId,
+		SeatNumbers: inp.SeatNumbers,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+type bookingPassengerInput struct {
+	SeatNumber        string `json:"seat_number" binding:"required"`
+	PassengerID       int64  `json:"passenger_id" binding:"omitempty,gt=0"`
+	PassengerName     string `json:"passenger_name" binding:"required_without=PassengerID"`
+	PassengerPassport string `json:"passenger_passport" binding:"required_without=PassengerID"`
+}
+
 type createBookingInput struct {
-	FlightId          int64   `json:"flight_id" binding:"required,gt=0"`
-	SeatNumber        string  `json:"seat_number" binding:"required"`
-	PassengerName     string  `json:"passenger_name" binding:"required"`
-	PassengerPassport string  `json:"passenger_passport" binding:"required"`
-	Price             float64 `json:"price" binding:"required,gt=0"`
-	Currency          string  `json:"currency"`
+	FlightId         int64                   `json:"flight_id" binding:"required,gt=0"`
+	Passengers       []bookingPassengerInput `json:"passengers" binding:"required,min=1,dive"`
+	QuotedPriceCents int64                   `json:"quoted_price_cents" binding:"required,gt=0"`
 }
 
 func (h *BookingHandler) CreateBooking(c *gin.Context) {
@@ -38,14 +71,22 @@ func (h *BookingHandler) CreateBooking(c *gin.Context) {
 		return
 	}
 
+	items := make([]*bookingv1.BookingPassenger, 0, len(inp.Passengers))
+	for _, p := range inp.Passengers {
+		items = append(items, &bookingv1.BookingPassenger{
+			SeatNumber:        p.SeatNumber,
+			PassengerId:       p.PassengerID,
+			PassengerName:     p.PassengerName,
+			PassengerPassport: p.PassengerPassport,
+		})
+	}
+
 	resp, err := h.client.CreateBooking(c.Request.Context(), &bookingv1.CreateBookingRequest{
-		UserId:            userID.(int64),
-		FlightId:          inp.FlightId,
-		SeatNumber:        inp.SeatNumber,
-		PassengerName:     inp.PassengerName,
-		PassengerPassport: inp.PassengerPassport,
-		PriceCents:        int64(inp.Price * 100),
-		Currency:          inp.Currency,
+		UserId:           userID.(int64),
+		FlightId:         inp.FlightId,
+		Items:            items,
+		QuotedPriceCents: inp.QuotedPriceCents,
+		IdempotencyKey:   c.GetHeader(idempotencyKeyHeader),
 	})
 	if err != nil {
 		mapGRPCErr(c, err)
@@ -64,10 +105,38 @@ func (h *BookingHandler) GetBooking(c *gin.Context) {
 		return
 	}
 
-	// TODO: check owner
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
 
 	resp, err := h.client.GetBooking(c.Request.Context(), &bookingv1.GetBookingRequest{
 		BookingId: bookingID,
+		Caller:    caller,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.Booking)
+}
+
+type lookupBookingInput struct {
+	Ref      string `form:"ref" binding:"required,len=6,alphanum"`
+	LastName string `form:"last_name" binding:"required"`
+}
+
+func (h *BookingHandler) LookupBooking(c *gin.Context) {
+	var inp lookupBookingInput
+	if err := c.ShouldBindQuery(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.client.LookupBooking(c.Request.Context(), &bookingv1.LookupBookingRequest{
+		Pnr:      inp.Ref,
+		LastName: inp.LastName,
 	})
 	if err != nil {
 		mapGRPCErr(c, err)
@@ -77,6 +146,40 @@ func (h *BookingHandler) GetBooking(c *gin.Context) {
 	c.JSON(http.StatusOK, resp.Booking)
 }
 
+func (h *BookingHandler) GetBookingHistory(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.GetBookingHistory(c.Request.Context(), &bookingv1.GetBookingHistoryRequest{
+		BookingId: bookingID,
+		Caller:    caller,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.History)
+}
+
+type listBookingsInput struct {
+	PageSize      int32     `form:"page_size" binding:"omitempty,gte=1,lte=100"`
+	Cursor        string    `form:"cursor"`
+	Statuses      []string  `form:"status"`
+	FlightId      int64     `form:"flight_id" binding:"omitempty,gt=0"`
+	DepartureFrom time.Time `form:"departure_from" time_format:"2006-01-02"`
+	DepartureTo   time.Time `form:"departure_to" time_format:"2006-01-02"`
+	Sort          string    `form:"sort" binding:"omitempty,oneof=asc desc"`
+}
+
 func (h *BookingHandler) ListBookings(c *gin.Context) {
 	userID, exists := c.Get("userId")
 	if !exists {
@@ -84,15 +187,45 @@ func (h *BookingHandler) ListBookings(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.client.ListBookings(c.Request.Context(), &bookingv1.ListBookingsRequest{
-		UserId: userID.(int64),
-	})
+	var inp listBookingsInput
+	if err := c.ShouldBindQuery(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	req := &bookingv1.ListBookingsRequest{
+		UserId:    userID.(int64),
+		PageSize:  inp.PageSize,
+		Cursor:    inp.Cursor,
+		FlightId:  inp.FlightId,
+		SortOrder: inp.Sort,
+	}
+	// Both ?status=PAID&status=PENDING and ?status=PAID,PENDING are accepted.
+	for _, st := range inp.Statuses {
+		for _, part := range strings.Split(st, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				req.Statuses = append(req.Statuses, part)
+			}
+		}
+	}
+	if !inp.DepartureFrom.IsZero() {
+		req.DepartureFrom = timestamppb.New(inp.DepartureFrom)
+	}
+	if !inp.DepartureTo.IsZero() {
+		// departure_to is an inclusive date.
+		req.DepartureTo = timestamppb.New(inp.DepartureTo.AddDate(0, 0, 1))
+	}
+
+	resp, err := h.client.ListBookings(c.Request.Context(), req)
 	if err != nil {
 		mapGRPCErr(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, resp.Bookings)
+	c.JSON(http.StatusOK, gin.H{
+		"bookings":    resp.Bookings,
+		"next_cursor": resp.NextCursor,
+	})
 }
 
 func (h *BookingHandler) CancelBooking(c *gin.Context) {
@@ -102,10 +235,14 @@ func (h *BookingHandler) CancelBooking(c *gin.Context) {
 		return
 	}
 
-	// TODO: check owner
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
 
 	_, err := h.client.CancelBooking(c.Request.Context(), &bookingv1.CancelBookingRequest{
 		BookingId: bookingID,
+		Caller:    caller,
 	})
 	if err != nil {
 		mapGRPCErr(c, err)
@@ -116,3 +253,299 @@ func (h *BookingHandler) CancelBooking(c *gin.Context) {
 		"message": "booking cancelled",
 	})
 }
+
+type changeSeatInput struct {
+	OldSeatNumber string `json:"old_seat_number" binding:"required"`
+	NewSeatNumber string `json:"new_seat_number" binding:"required"`
+}
+
+func (h *BookingHandler) ChangeSeat(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	var inp changeSeatInput
+	if err := c.ShouldBindJSON(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.client.ChangeSeat(c.Request.Context(), &bookingv1.ChangeSeatRequest{
+		BookingId:     bookingID,
+		Caller:        caller,
+		OldSeatNumber: inp.OldSeatNumber,
+		NewSeatNumber: inp.NewSeatNumber,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *BookingHandler) CheckIn(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.CheckIn(c.Request.Context(), &bookingv1.CheckInRequest{
+		BookingId: bookingID,
+		Caller:    caller,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"boarding_passes": resp.BoardingPasses,
+	})
+}
+
+func (h *BookingHandler) GetRebookingOffer(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.GetRebookingOffer(c.Request.Context(), &bookingv1.GetRebookingOfferRequest{
+		BookingId: bookingID,
+		Caller:    caller,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.Offer)
+}
+
+func (h *BookingHandler) AcceptRebooking(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.AcceptRebooking(c.Request.Context(), &bookingv1.AcceptRebookingRequest{
+		BookingId: bookingID,
+		Caller:    caller,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"booking_id": resp.BookingId,
+	})
+}
+
+type boardingPassInput struct {
+	Format string `form:"format" binding:"omitempty,oneof=json png pdf"`
+	Seat   string `form:"seat"`
+}
+
+func (h *BookingHandler) GetBoardingPass(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	var inp boardingPassInput
+	if err := c.ShouldBindQuery(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	format := inp.Format
+	if format == "json" {
+		format = ""
+	}
+
+	resp, err := h.client.GetBoardingPass(c.Request.Context(), &bookingv1.GetBoardingPassRequest{
+		BookingId:  bookingID,
+		Caller:     caller,
+		Format:     format,
+		SeatNumber: inp.Seat,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	if len(resp.Content) > 0 {
+		c.Data(http.StatusOK, resp.ContentType, resp.Content)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"boarding_passes": resp.BoardingPasses,
+	})
+}
+
+type receiptInput struct {
+	Format string `form:"format" binding:"omitempty,oneof=html pdf"`
+}
+
+func (h *BookingHandler) GetItineraryReceipt(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	var inp receiptInput
+	if err := c.ShouldBindQuery(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.client.GetItineraryReceipt(c.Request.Context(), &bookingv1.GetItineraryReceiptRequest{
+		BookingId: bookingID,
+		Caller:    caller,
+		Format:    inp.Format,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	if inp.Format == "pdf" {
+		c.Header("Content-Disposition", `attachment; filename="receipt-`+bookingID+`.pdf"`)
+	}
+	c.Data(http.StatusOK, resp.ContentType, resp.Content)
+}
+
+type deadOutboxEventsInput struct {
+	Limit int32 `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+func (h *BookingHandler) ListDeadOutboxEvents(c *gin.Context) {
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	var inp deadOutboxEventsInput
+	if err := c.ShouldBindQuery(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.client.ListDeadOutboxEvents(c.Request.Context(), &bookingv1.ListDeadOutboxEventsRequest{
+		Caller: caller,
+		Limit:  inp.Limit,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events": resp.Events,
+	})
+}
+
+func (h *BookingHandler) ReplayOutboxEvent(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty event id")
+		return
+	}
+
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	_, err := h.client.ReplayOutboxEvent(c.Request.Context(), &bookingv1.ReplayOutboxEventRequest{
+		Caller:  caller,
+		EventId: eventID,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+type sagasInput struct {
+	State     string `form:"state"`
+	BookingID string `form:"booking_id"`
+	Limit     int32  `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+func (h *BookingHandler) ListSagas(c *gin.Context) {
+	caller, ok := bookingCaller(c)
+	if !ok {
+		return
+	}
+
+	var inp sagasInput
+	if err := c.ShouldBindQuery(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.client.ListSagas(c.Request.Context(), &bookingv1.ListSagasRequest{
+		Caller:    caller,
+		State:     inp.State,
+		BookingId: inp.BookingID,
+		Limit:     inp.Limit,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sagas": resp.Sagas,
+	})
+}
+
+func bookingCaller(c *gin.Context) (*bookingv1.Caller, bool) {
+	id, role, ok := authenticatedCaller(c)
+	if !ok {
+		return nil, false
+	}
+	return &bookingv1.Caller{UserId: id, Role: role}, true
+}
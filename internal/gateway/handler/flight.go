@@ -105,6 +105,36 @@ func (h *FlightHandler) CreateFlight(c *gin.Context) {
 	})
 }
 
+type cancelFlightInput struct {
+	Reason string `json:"reason" binding:"max=255"`
+}
+
+func (h *FlightHandler) CancelFlight(c *gin.Context) {
+	flightID, err := parseIDParam(c, "id")
+	if err != nil {
+		return
+	}
+
+	var input cancelFlightInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	resp, err := h.client.CancelFlight(c.Request.Context(), &flightv1.CancelFlightRequest{
+		FlightId: flightID,
+		Reason:   input.Reason,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.Flight)
+}
+
 func (h *FlightHandler) GetFlightDetails(c *gin.Context) {
 	flightID, err := parseIDParam(c, "id")
 	if err != nil {
@@ -54,3 +54,24 @@ func verifyUserOwnership(c *gin.Context, targetUserID int64) bool {
 
 	return true
 }
+
+// authenticatedCaller returns the user ID and role AuthMiddleware stored,
+// answering the request itself when they are missing.
+func authenticatedCaller(c *gin.Context) (int64, string, bool) {
+	userID, exists := c.Get("userId")
+	if !exists {
+		newErrorResponse(c, http.StatusUnauthorized, ErrUserUnauthorized)
+		return 0, "", false
+	}
+
+	id, ok := userID.(int64)
+	if !ok {
+		newErrorResponse(c, http.StatusInternalServerError, ErrInternalServer)
+		return 0, "", false
+	}
+
+	role, _ := c.Get("role")
+	roleStr, _ := role.(string)
+
+	return id, roleStr, true
+}
@@ -11,17 +11,20 @@ type GatewayHandler struct {
 	User    *UserHandler
 	Flight  *FlightHandler
 	Booking *BookingHandler
+	Payment *PaymentHandler
 }
 
 func NewGatewayHandler(
 	user *UserHandler,
 	flight *FlightHandler,
 	booking *BookingHandler,
+	payment *PaymentHandler,
 ) *GatewayHandler {
 	return &GatewayHandler{
 		User:    user,
 		Flight:  flight,
 		Booking: booking,
+		Payment: payment,
 	}
 }
 
@@ -37,10 +40,12 @@ func mapGRPCErr(c *gin.Context, err error) {
 		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
 	case codes.InvalidArgument:
 		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
-	case codes.AlreadyExists:
+	case codes.AlreadyExists, codes.FailedPrecondition:
 		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
 	case codes.Unauthenticated:
 		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+	case codes.PermissionDenied:
+		c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
 	default:
 		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
 	}
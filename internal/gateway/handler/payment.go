@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	paymentv1 "github.com/squ1ky/flyte/gen/go/payment"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type PaymentHandler struct {
+	client paymentv1.PaymentServiceClient
+}
+
+func NewPaymentHandler(client paymentv1.PaymentServiceClient) *PaymentHandler {
+	return &PaymentHandler{
+		client: client,
+	}
+}
+
+type listPaymentsInput struct {
+	UserID      int64     `form:"user_id" binding:"omitempty,gt=0"`
+	PageSize    int32     `form:"page_size" binding:"omitempty,gte=1,lte=100"`
+	Cursor      string    `form:"cursor"`
+	Statuses    []string  `form:"status"`
+	CreatedFrom time.Time `form:"created_from" time_format:"2006-01-02"`
+	CreatedTo   time.Time `form:"created_to" time_format:"2006-01-02"`
+}
+
+// ListPayments lists the caller's payments, admins may pass user_id to see
+// another user's or leave it out to see everybody's.
+func (h *PaymentHandler) ListPayments(c *gin.Context) {
+	caller, ok := paymentCaller(c)
+	if !ok {
+		return
+	}
+
+	var inp listPaymentsInput
+	if err := c.ShouldBindQuery(&inp); err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	req := &paymentv1.ListPaymentsRequest{
+		Caller:   caller,
+		UserId:   inp.UserID,
+		PageSize: inp.PageSize,
+		Cursor:   inp.Cursor,
+	}
+	// Both ?status=SUCCESS&status=FAILED and ?status=SUCCESS,FAILED are accepted.
+	for _, st := range inp.Statuses {
+		for _, part := range strings.Split(st, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				req.Statuses = append(req.Statuses, part)
+			}
+		}
+	}
+	if !inp.CreatedFrom.IsZero() {
+		req.CreatedFrom = timestamppb.New(inp.CreatedFrom)
+	}
+	if !inp.CreatedTo.IsZero() {
+		// created_to is an inclusive date.
+		req.CreatedTo = timestamppb.New(inp.CreatedTo.AddDate(0, 0, 1))
+	}
+
+	resp, err := h.client.ListPayments(c.Request.Context(), req)
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"payments":    resp.Payments,
+		"next_cursor": resp.NextCursor,
+	})
+}
+
+func (h *PaymentHandler) GetBookingPayment(c *gin.Context) {
+	bookingID := c.Param("id")
+	if bookingID == "" {
+		newErrorResponse(c, http.StatusBadRequest, "empty booking id")
+		return
+	}
+
+	caller, ok := paymentCaller(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.GetPaymentByBooking(c.Request.Context(), &paymentv1.GetPaymentByBookingRequest{
+		BookingId: bookingID,
+		Caller:    caller,
+	})
+	if err != nil {
+		mapGRPCErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"payment":            resp.Payment,
+		"additional_charges": resp.AdditionalCharges,
+		"refunds":            resp.Refunds,
+	})
+}
+
+func paymentCaller(c *gin.Context) (*paymentv1.Caller, bool) {
+	id, role, ok := authenticatedCaller(c)
+	if !ok {
+		return nil, false
+	}
+	return &paymentv1.Caller{UserId: id, Role: role}, true
+}
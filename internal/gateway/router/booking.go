@@ -11,11 +11,33 @@ func RegisterBookingRoutes(
 	h *handler.GatewayHandler,
 	userClient userv1.UserServiceClient,
 ) {
+	// Manage-my-booking lookup is public, the surname check replaces auth.
+	rg.GET("/bookings/lookup", h.Booking.LookupBooking)
+
 	bookings := rg.Group("/bookings", AuthMiddleware(userClient))
 	{
 		bookings.POST("/", h.Booking.CreateBooking)
+		bookings.POST("/quote", h.Booking.QuoteBooking)
 		bookings.GET("/", h.Booking.ListBookings)
 		bookings.GET("/:id", h.Booking.GetBooking)
+		bookings.GET("/:id/history", h.Booking.GetBookingHistory)
 		bookings.POST("/:id/cancel", h.Booking.CancelBooking)
+		bookings.POST("/:id/change-seat", h.Booking.ChangeSeat)
+		bookings.POST("/:id/check-in", h.Booking.CheckIn)
+		bookings.GET("/:id/boarding-pass", h.Booking.GetBoardingPass)
+		bookings.GET("/:id/receipt", h.Booking.GetItineraryReceipt)
+		bookings.GET("/:id/rebooking", h.Booking.GetRebookingOffer)
+		bookings.POST("/:id/rebooking/accept", h.Booking.AcceptRebooking)
+	}
+
+	admin := rg.Group("/admin/bookings/outbox", AuthMiddleware(userClient), AdminOnlyMiddleware())
+	{
+		admin.GET("/dead", h.Booking.ListDeadOutboxEvents)
+		admin.POST("/:id/replay", h.Booking.ReplayOutboxEvent)
+	}
+
+	sagas := rg.Group("/admin/bookings/sagas", AuthMiddleware(userClient), AdminOnlyMiddleware())
+	{
+		sagas.GET("/", h.Booking.ListSagas)
 	}
 }
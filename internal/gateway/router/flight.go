@@ -20,6 +20,7 @@ func RegisterFlightRoutes(rg *gin.RouterGroup, h *handler.GatewayHandler, userCl
 	admin := rg.Group("", AuthMiddleware(userClient), AdminOnlyMiddleware())
 	{
 		admin.POST("/flights", h.Flight.CreateFlight)
+		admin.POST("/flights/:id/cancel", h.Flight.CancelFlight)
 		admin.POST("/aircrafts", h.Flight.CreateAircraft)
 		admin.POST("/aircrafts/:id/seats", h.Flight.AddAircraftSeats)
 	}
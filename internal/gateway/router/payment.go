@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	userv1 "github.com/squ1ky/flyte/gen/go/user"
+	"github.com/squ1ky/flyte/internal/gateway/handler"
+)
+
+func RegisterPaymentRoutes(
+	rg *gin.RouterGroup,
+	h *handler.GatewayHandler,
+	userClient userv1.UserServiceClient,
+) {
+	payments := rg.Group("/payments", AuthMiddleware(userClient))
+	{
+		payments.GET("/", h.Payment.ListPayments)
+	}
+
+	rg.GET("/bookings/:id/payment", AuthMiddleware(userClient), h.Payment.GetBookingPayment)
+}
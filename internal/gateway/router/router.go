@@ -14,6 +14,7 @@ func InitRoutes(h *handler.GatewayHandler, userClient userv1.UserServiceClient)
 		RegisterUserRoutes(api, h, userClient)
 		RegisterFlightRoutes(api, h, userClient)
 		RegisterBookingRoutes(api, h, userClient)
+		RegisterPaymentRoutes(api, h, userClient)
 	}
 
 	return router
@@ -3,12 +3,25 @@ package config
 import (
 	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 type Config struct {
-	Env   string `env:"ENV" env-default:"local"`
-	DB    DBConfig
-	Kafka KafkaConfig
+	Env      string `env:"ENV" env-default:"local"`
+	GRPC     GRPCConfig
+	DB       DBConfig
+	Kafka    KafkaConfig
+	Outbox   OutboxConfig
+	Recovery RecoveryConfig
+	Leader   LeaderConfig
+	// Provider picks the payment provider: "fake" answers by test card rules,
+	// "simulator" imitates a bank with random latency and declines.
+	Provider string `env:"PAYMENT_PROVIDER" env-default:"fake"`
+}
+
+type GRPCConfig struct {
+	Port    int           `env:"PAYMENT_GRPC_PORT" env-default:"50054"`
+	Timeout time.Duration `env:"PAYMENT_GRPC_TIMEOUT" env-default:"5s"`
 }
 
 type DBConfig struct {
@@ -25,6 +38,33 @@ type KafkaConfig struct {
 	TopicRequests string   `env:"KAFKA_TOPIC_PAYMENT_REQUESTS" env-required:"true"`
 	TopicResults  string   `env:"KAFKA_TOPIC_PAYMENT_RESULTS" env-required:"true"`
 	GroupID       string   `env:"PAYMENT_KAFKA_GROUP_ID" env-required:"true"`
+	// RetryDelays are the delays of the consumer's retry topics, a message
+	// that still fails after the last one is dead-lettered.
+	RetryDelays []time.Duration `env:"KAFKA_RETRY_DELAYS" env-default:"10s,1m,10m"`
+}
+
+type OutboxConfig struct {
+	Interval    time.Duration `env:"PAYMENT_OUTBOX_INTERVAL" env-default:"1s"`
+	BatchSize   int           `env:"PAYMENT_OUTBOX_BATCH_SIZE" env-default:"50"`
+	MaxAttempts int           `env:"PAYMENT_OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+	BaseBackoff time.Duration `env:"PAYMENT_OUTBOX_BASE_BACKOFF" env-default:"1s"`
+	MaxBackoff  time.Duration `env:"PAYMENT_OUTBOX_MAX_BACKOFF" env-default:"10m"`
+}
+
+// RecoveryConfig controls the worker resuming payments stuck in PENDING. A
+// payment is stuck once it has been pending for StuckAfter, it is failed after
+// MaxAttempts resumptions.
+type RecoveryConfig struct {
+	Interval    time.Duration `env:"PAYMENT_RECOVERY_INTERVAL" env-default:"30s"`
+	BatchSize   int           `env:"PAYMENT_RECOVERY_BATCH_SIZE" env-default:"50"`
+	StuckAfter  time.Duration `env:"PAYMENT_RECOVERY_STUCK_AFTER" env-default:"2m"`
+	MaxAttempts int           `env:"PAYMENT_RECOVERY_MAX_ATTEMPTS" env-default:"5"`
+}
+
+// LeaderConfig controls the election of the replica running the background
+// workers.
+type LeaderConfig struct {
+	Interval time.Duration `env:"PAYMENT_LEADER_INTERVAL" env-default:"5s"`
 }
 
 func Load() (*Config, error) {
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PaymentFilter narrows down the listed payments. Zero values mean "no filter".
+type PaymentFilter struct {
+	UserID      int64
+	Statuses    []PaymentStatus
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Limit       int
+	After       *PaymentCursor
+}
+
+// PaymentCursor points at the last payment of a page, ordered by created_at then id.
+type PaymentCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+func CursorFor(p *Payment) *PaymentCursor {
+	return &PaymentCursor{CreatedAt: p.CreatedAt, ID: p.ID}
+}
+
+func (c *PaymentCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodePaymentCursor(s string) (*PaymentCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	return &PaymentCursor{CreatedAt: time.Unix(0, n).UTC(), ID: id}, nil
+}
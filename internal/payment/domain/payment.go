@@ -6,30 +6,86 @@ import (
 )
 
 var (
-	ErrPaymentNotFound = errors.New("payment not found")
+	ErrPaymentNotFound     = errors.New("payment not found")
+	ErrPaymentAccessDenied = errors.New("payment belongs to another user")
+	ErrStatusChanged       = errors.New("payment status changed concurrently")
+	ErrAdminOnly           = errors.New("operation is available to admins only")
 )
 
 type PaymentStatus string
 
 const (
 	PaymentStatusPending PaymentStatus = "PENDING"
-	PaymentStatusSuccess PaymentStatus = "SUCCESS"
-	PaymentStatusFailed  PaymentStatus = "FAILED"
+
+	// PaymentStatusAuthorized holds the money on the card until the booking's
+	// seats are confirmed, the payment is then captured (SUCCESS) or voided.
+	PaymentStatusAuthorized PaymentStatus = "AUTHORIZED"
+	PaymentStatusVoided     PaymentStatus = "VOIDED"
+
+	PaymentStatusSuccess  PaymentStatus = "SUCCESS"
+	PaymentStatusFailed   PaymentStatus = "FAILED"
+	PaymentStatusRefunded PaymentStatus = "REFUNDED"
 )
 
+func (s PaymentStatus) IsValid() bool {
+	switch s {
+	case PaymentStatusPending, PaymentStatusAuthorized, PaymentStatusVoided,
+		PaymentStatusSuccess, PaymentStatusFailed, PaymentStatusRefunded:
+		return true
+	default:
+		return false
+	}
+}
+
 type Payment struct {
 	ID           string        `db:"id"`
 	BookingID    string        `db:"booking_id"`
+	Reference    string        `db:"reference"`
 	UserID       int64         `db:"user_id"`
 	AmountCents  int64         `db:"amount_cents"`
 	Currency     string        `db:"currency"`
 	Status       PaymentStatus `db:"status"`
 	ErrorMessage *string       `db:"error_message"`
+	Provider     string        `db:"provider"`
+	ProviderRef  *string       `db:"provider_ref"`
 	CreatedAt    time.Time     `db:"created_at"`
 	ProcessedAt  *time.Time    `db:"processed_at"`
+	RefundedAt   *time.Time    `db:"refunded_at"`
+
+	// RefundedCents counts the pending and successful refunds of the payment.
+	RefundedCents int64 `db:"refunded_cents"`
+	// RecoveryAttempts counts how often the recovery worker picked up the
+	// payment while it was stuck in PENDING.
+	RecoveryAttempts int `db:"recovery_attempts"`
+}
+
+// RefundableCents returns how much of a captured payment can still be
+// refunded.
+func (p *Payment) RefundableCents() int64 {
+	if p.Status != PaymentStatusSuccess {
+		return 0
+	}
+	return p.AmountCents - p.RefundedCents
 }
 
 type CreatePaymentResult struct {
 	Payment *Payment
 	IsNew   bool
 }
+
+type PaymentAction string
+
+const (
+	PaymentActionCapture PaymentAction = "CAPTURE"
+	PaymentActionVoid    PaymentAction = "VOID"
+)
+
+// ActionResult answers a capture or void request, Status is SUCCESS or
+// FAILED.
+type ActionResult struct {
+	Action       PaymentAction
+	BookingID    string
+	PaymentID    string
+	Status       PaymentStatus
+	ErrorMessage string
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrRefundExists    = errors.New("refund key already used")
+	ErrRefundExceeded  = errors.New("refund exceeds the refundable amount")
+	ErrRefundKeyReused = errors.New("refund key used for another request")
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusPending RefundStatus = "PENDING"
+	RefundStatusSuccess RefundStatus = "SUCCESS"
+	RefundStatusFailed  RefundStatus = "FAILED"
+)
+
+// Refund gives back part or all of a captured payment. A payment may be
+// refunded several times, up to its amount.
+type Refund struct {
+	ID           string       `db:"id"`
+	PaymentID    string       `db:"payment_id"`
+	BookingID    string       `db:"booking_id"`
+	RefundKey    string       `db:"refund_key"`
+	AmountCents  int64        `db:"amount_cents"`
+	Currency     string       `db:"currency"`
+	Status       RefundStatus `db:"status"`
+	Reason       *string      `db:"reason"`
+	ErrorMessage *string      `db:"error_message"`
+	CreatedAt    time.Time    `db:"created_at"`
+	ProcessedAt  *time.Time   `db:"processed_at"`
+}
+
+// RefundRequest refunds AmountCents of a booking's captured payments, oldest
+// payment first. Requests are idempotent per RefundKey.
+type RefundRequest struct {
+	BookingID   string
+	RefundKey   string
+	AmountCents int64
+	Reason      string
+	// Full refunds everything captured and not refunded yet, AmountCents is
+	// ignored then.
+	Full bool
+}
+
+type RefundResult struct {
+	BookingID    string
+	PaymentID    string
+	RefundKey    string
+	AmountCents  int64
+	Status       RefundStatus
+	ErrorMessage string
+	Refunds      []Refund
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	paymentv1 "github.com/squ1ky/flyte/gen/go/payment"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+func (s *Server) RefundPayment(ctx context.Context, req *paymentv1.RefundPaymentRequest) (*paymentv1.RefundPaymentResponse, error) {
+	if err := validateRefundPaymentRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	result, err := s.svc.RefundPayment(ctx, mapCallerFromProto(req.Caller), domain.RefundRequest{
+		BookingID:   strings.TrimSpace(req.BookingId),
+		RefundKey:   strings.TrimSpace(req.RefundKey),
+		AmountCents: req.AmountCents,
+		Reason:      strings.TrimSpace(req.Reason),
+	})
+	if err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refund payment: %v", err)
+	}
+
+	return &paymentv1.RefundPaymentResponse{
+		Status:        string(result.Status),
+		ErrorMessage:  result.ErrorMessage,
+		RefundedCents: result.AmountCents,
+		Refunds:       mapRefundsToProto(result.Refunds),
+	}, nil
+}
+
+func mapRefundsToProto(refunds []domain.Refund) []*paymentv1.Refund {
+	out := make([]*paymentv1.Refund, 0, len(refunds))
+	for i := range refunds {
+		out = append(out, mapRefundToProto(&refunds[i]))
+	}
+	return out
+}
+
+func mapRefundToProto(r *domain.Refund) *paymentv1.Refund {
+	out := &paymentv1.Refund{
+		Id:          r.ID,
+		PaymentId:   r.PaymentID,
+		BookingId:   r.BookingID,
+		RefundKey:   r.RefundKey,
+		AmountCents: r.AmountCents,
+		Currency:    r.Currency,
+		Status:      string(r.Status),
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+	}
+	if r.Reason != nil {
+		out.Reason = *r.Reason
+	}
+	if r.ErrorMessage != nil {
+		out.ErrorMessage = *r.ErrorMessage
+	}
+	if r.ProcessedAt != nil {
+		out.ProcessedAt = timestamppb.New(*r.ProcessedAt)
+	}
+	return out
+}
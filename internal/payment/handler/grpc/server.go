@@ -0,0 +1,190 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	paymentv1 "github.com/squ1ky/flyte/gen/go/payment"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+	"github.com/squ1ky/flyte/internal/payment/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+type Server struct {
+	paymentv1.UnimplementedPaymentServiceServer
+
+	svc     *service.PaymentService
+	timeout time.Duration
+}
+
+func NewServer(svc *service.PaymentService, timeout time.Duration) *Server {
+	return &Server{
+		svc:     svc,
+		timeout: timeout,
+	}
+}
+
+func (s *Server) Register(gRPCServer *grpc.Server) {
+	paymentv1.RegisterPaymentServiceServer(gRPCServer, s)
+}
+
+func (s *Server) GetPayment(ctx context.Context, req *paymentv1.GetPaymentRequest) (*paymentv1.GetPaymentResponse, error) {
+	if err := validateGetPaymentRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	payment, err := s.svc.GetPayment(ctx, strings.TrimSpace(req.PaymentId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get payment: %v", err)
+	}
+
+	return &paymentv1.GetPaymentResponse{Payment: mapPaymentToProto(payment)}, nil
+}
+
+func (s *Server) GetPaymentByBooking(
+	ctx context.Context,
+	req *paymentv1.GetPaymentByBookingRequest,
+) (*paymentv1.GetPaymentByBookingResponse, error) {
+	if err := validateGetPaymentByBookingRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	payment, charges, refunds, err := s.svc.GetPaymentByBooking(ctx, strings.TrimSpace(req.BookingId), mapCallerFromProto(req.Caller))
+	if err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get booking payment: %v", err)
+	}
+
+	resp := &paymentv1.GetPaymentByBookingResponse{
+		Payment:           mapPaymentToProto(payment),
+		AdditionalCharges: make([]*paymentv1.Payment, 0, len(charges)),
+		Refunds:           mapRefundsToProto(refunds),
+	}
+	for i := range charges {
+		resp.AdditionalCharges = append(resp.AdditionalCharges, mapPaymentToProto(&charges[i]))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListPayments(ctx context.Context, req *paymentv1.ListPaymentsRequest) (*paymentv1.ListPaymentsResponse, error) {
+	if err := validateListPaymentsRequest(req); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	filter, err := mapListFilterFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, next, err := s.svc.ListPayments(ctx, mapCallerFromProto(req.Caller), filter)
+	if err != nil {
+		if st := accessErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list payments: %v", err)
+	}
+
+	out := make([]*paymentv1.Payment, 0, len(list))
+	for i := range list {
+		out = append(out, mapPaymentToProto(&list[i]))
+	}
+
+	resp := &paymentv1.ListPaymentsResponse{Payments: out}
+	if next != nil {
+		resp.NextCursor = next.Encode()
+	}
+
+	return resp, nil
+}
+
+func accessErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPaymentNotFound):
+		return status.Error(codes.NotFound, domain.ErrPaymentNotFound.Error())
+	case errors.Is(err, domain.ErrPaymentAccessDenied):
+		return status.Error(codes.PermissionDenied, domain.ErrPaymentAccessDenied.Error())
+	case errors.Is(err, domain.ErrAdminOnly):
+		return status.Error(codes.PermissionDenied, domain.ErrAdminOnly.Error())
+	default:
+		return nil
+	}
+}
+
+func mapListFilterFromProto(req *paymentv1.ListPaymentsRequest) (domain.PaymentFilter, error) {
+	filter := domain.PaymentFilter{
+		UserID: req.UserId,
+		Limit:  int(req.PageSize),
+	}
+
+	for _, st := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, domain.PaymentStatus(strings.ToUpper(strings.TrimSpace(st))))
+	}
+	if req.CreatedFrom != nil {
+		from := req.CreatedFrom.AsTime()
+		filter.CreatedFrom = &from
+	}
+	if req.CreatedTo != nil {
+		to := req.CreatedTo.AsTime()
+		filter.CreatedTo = &to
+	}
+
+	if req.Cursor != "" {
+		cursor, err := domain.DecodePaymentCursor(req.Cursor)
+		if err != nil {
+			return domain.PaymentFilter{}, err
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
+}
+
+func mapCallerFromProto(c *paymentv1.Caller) domain.Caller {
+	return domain.Caller{
+		UserID: c.GetUserId(),
+		Role:   c.GetRole(),
+	}
+}
+
+func mapPaymentToProto(p *domain.Payment) *paymentv1.Payment {
+	out := &paymentv1.Payment{
+		Id:            p.ID,
+		BookingId:     p.BookingID,
+		Reference:     p.Reference,
+		UserId:        p.UserID,
+		AmountCents:   p.AmountCents,
+		Currency:      p.Currency,
+		Status:        string(p.Status),
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		RefundedCents: p.RefundedCents,
+	}
+	if p.ErrorMessage != nil {
+		out.ErrorMessage = *p.ErrorMessage
+	}
+	if p.ProcessedAt != nil {
+		out.ProcessedAt = timestamppb.New(*p.ProcessedAt)
+	}
+	if p.RefundedAt != nil {
+		out.RefundedAt = timestamppb.New(*p.RefundedAt)
+	}
+	return out
+}
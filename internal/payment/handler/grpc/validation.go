@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	paymentv1 "github.com/squ1ky/flyte/gen/go/payment"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// MaxRefundKeyLength matches the refund_key column.
+const MaxRefundKeyLength = 128
+
+func validateGetPaymentRequest(req *paymentv1.GetPaymentRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.PaymentId) == "" {
+		return status.Error(codes.InvalidArgument, "payment_id is required")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateGetPaymentByBookingRequest(req *paymentv1.GetPaymentByBookingRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateListPaymentsRequest(req *paymentv1.ListPaymentsRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.UserId < 0 {
+		return status.Error(codes.InvalidArgument, "user_id must not be negative")
+	}
+	if req.PageSize < 0 {
+		return status.Error(codes.InvalidArgument, "page_size must be >= 0")
+	}
+	for _, st := range req.Statuses {
+		if !domain.PaymentStatus(strings.ToUpper(strings.TrimSpace(st))).IsValid() {
+			return status.Errorf(codes.InvalidArgument, "unknown status %q", st)
+		}
+	}
+	if req.CreatedFrom != nil && req.CreatedTo != nil &&
+		!req.CreatedFrom.AsTime().Before(req.CreatedTo.AsTime()) {
+		return status.Error(codes.InvalidArgument, "created_from must be before created_to")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateRefundPaymentRequest(req *paymentv1.RefundPaymentRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.BookingId) == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	if strings.TrimSpace(req.RefundKey) == "" {
+		return status.Error(codes.InvalidArgument, "refund_key is required")
+	}
+	if len(req.RefundKey) > MaxRefundKeyLength {
+		return status.Errorf(codes.InvalidArgument, "refund_key must be at most %d characters", MaxRefundKeyLength)
+	}
+	if req.AmountCents <= 0 {
+		return status.Error(codes.InvalidArgument, "amount_cents must be > 0")
+	}
+	return validateCaller(req.Caller)
+}
+
+func validateCaller(caller *paymentv1.Caller) error {
+	if caller == nil {
+		return status.Error(codes.InvalidArgument, "caller is required")
+	}
+	if caller.UserId <= 0 {
+		return status.Error(codes.InvalidArgument, "caller.user_id must be > 0")
+	}
+	return nil
+}
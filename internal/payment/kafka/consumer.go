@@ -2,25 +2,20 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/segmentio/kafka-go"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
 	"github.com/squ1ky/flyte/internal/payment/config"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"github.com/squ1ky/flyte/pkg/kafkaretry"
 	"log/slog"
-	"time"
 )
 
-type PaymentRequestDTO struct {
-	BookingID   string `json:"booking_id"`
-	UserID      int64  `json:"user_id"`
-	AmountCents int64  `json:"amount_cents"`
-	Currency    string `json:"currency"`
-}
-
 type PaymentConsumer struct {
-	reader  *kafka.Reader
-	handler MessageHandler
-	log     *slog.Logger
+	consumer *kafkaretry.Consumer
+	handler  MessageHandler
+	log      *slog.Logger
 }
 
 func NewPaymentConsumer(
@@ -28,67 +23,88 @@ func NewPaymentConsumer(
 	handler MessageHandler,
 	log *slog.Logger,
 ) *PaymentConsumer {
-	reader := kafka.NewReader(kafka.ReaderConfig{
-		Brokers:  cfg.Brokers,
-		Topic:    cfg.TopicRequests,
-		GroupID:  cfg.GroupID,
-		MinBytes: 10e3,
-		MaxBytes: 10e6,
-	})
-
-	return &PaymentConsumer{
-		reader:  reader,
+	c := &PaymentConsumer{
 		handler: handler,
 		log:     log,
 	}
+	c.consumer = kafkaretry.NewConsumer(kafkaretry.Config{
+		Brokers: cfg.Brokers,
+		Topic:   cfg.TopicRequests,
+		GroupID: cfg.GroupID,
+		Delays:  cfg.RetryDelays,
+	}, inbox.Wrap(cfg.GroupID, c.processMessage, log), log)
+
+	return c
 }
 
 func (c *PaymentConsumer) Start(ctx context.Context) error {
-	c.log.Info("starting kafka consumer", "topic", c.reader.Config().Topic)
+	return c.consumer.Start(ctx)
+}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
+func (c *PaymentConsumer) processMessage(ctx context.Context, m kafka.Message) error {
+	switch eventType := headerValue(m, eventcodec.HeaderEventType); eventType {
+	case eventcodec.EventTypeRefundRequest:
+		var req eventsv1.RefundRequest
+		if err := eventcodec.Unmarshal(m, &req); err != nil {
+			return kafkaretry.Permanent(fmt.Errorf("failed to decode refund request: %w", err))
 		}
 
-		m, err := c.reader.FetchMessage(ctx)
-		if err != nil {
-			c.log.Error("failed to fetch message", "error", err)
-			time.Sleep(time.Second)
-			continue
+		c.log.Info("received refund request",
+			"booking_id", req.BookingId,
+			"refund_key", req.RefundKey,
+			"amount", req.AmountCents,
+			"offset", m.Offset)
+
+		return c.handler.HandleRefundRequest(ctx, &req)
+	case eventcodec.EventTypePaymentCaptureRequest:
+		var req eventsv1.PaymentCaptureRequest
+		if err := eventcodec.Unmarshal(m, &req); err != nil {
+			return kafkaretry.Permanent(fmt.Errorf("failed to decode capture request: %w", err))
 		}
 
-		if err := c.processMessage(ctx, m); err != nil {
-			c.log.Error("failed to process message", "error", err, "offset", m.Offset)
-			// Dead Letter Queue or Retry Policy
-			continue
+		c.log.Info("received capture request",
+			"booking_id", req.BookingId,
+			"offset", m.Offset)
+
+		return c.handler.HandleCaptureRequest(ctx, &req)
+	case eventcodec.EventTypePaymentVoidRequest:
+		var req eventsv1.PaymentVoidRequest
+		if err := eventcodec.Unmarshal(m, &req); err != nil {
+			return kafkaretry.Permanent(fmt.Errorf("failed to decode void request: %w", err))
 		}
 
-		if err := c.reader.CommitMessages(ctx, m); err != nil {
-			c.log.Error("failed to commit message", "error", err, "offset", m.Offset)
+		c.log.Info("received void request",
+			"booking_id", req.BookingId,
+			"reason", req.Reason,
+			"offset", m.Offset)
+
+		return c.handler.HandleVoidRequest(ctx, &req)
+	case eventcodec.EventTypePaymentRequest, "":
+		var req eventsv1.PaymentRequest
+		if err := eventcodec.Unmarshal(m, &req); err != nil {
+			return kafkaretry.Permanent(fmt.Errorf("failed to decode request: %w", err))
 		}
+
+		c.log.Info("received payment request",
+			"booking_id", req.BookingId,
+			"amount", req.AmountCents,
+			"offset", m.Offset)
+
+		return c.handler.HandlePaymentRequest(ctx, &req)
+	default:
+		return kafkaretry.Permanent(fmt.Errorf("unknown event type %q", eventType))
 	}
 }
 
-func (c *PaymentConsumer) processMessage(ctx context.Context, m kafka.Message) error {
-	var req PaymentRequestDTO
-	if err := json.Unmarshal(m.Value, &req); err != nil {
-		return fmt.Errorf("failed to unmarshal request: %w", err)
+func headerValue(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
 	}
-
-	c.log.Info("received payment request",
-		"booking_id", req.BookingID,
-		"amount", req.AmountCents,
-		"offset", m.Offset)
-
-	return c.handler.HandlePaymentRequest(ctx, req)
+	return ""
 }
 
 func (c *PaymentConsumer) Close() error {
-	if c.reader != nil {
-		return c.reader.Close()
-	}
-	return nil
+	return c.consumer.Close()
 }
@@ -3,40 +3,68 @@ package kafka
 import (
 	"context"
 	"fmt"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/internal/payment/domain"
 	"github.com/squ1ky/flyte/internal/payment/service"
 	"log/slog"
 )
 
 type MessageHandler interface {
-	HandlePaymentRequest(ctx context.Context, req PaymentRequestDTO) error
+	HandlePaymentRequest(ctx context.Context, req *eventsv1.PaymentRequest) error
+	HandleRefundRequest(ctx context.Context, req *eventsv1.RefundRequest) error
+	HandleCaptureRequest(ctx context.Context, req *eventsv1.PaymentCaptureRequest) error
+	HandleVoidRequest(ctx context.Context, req *eventsv1.PaymentVoidRequest) error
 }
 
+// PaymentMessageHandler processes payment requests. The service writes every
+// result to the outbox together with the change it reports, the outbox relay
+// publishes it.
 type PaymentMessageHandler struct {
-	service  *service.PaymentService
-	producer *PaymentProducer
-	log      *slog.Logger
+	service *service.PaymentService
+	log     *slog.Logger
 }
 
-func NewPaymentMessageHandler(
-	service *service.PaymentService,
-	producer *PaymentProducer,
-	log *slog.Logger,
-) *PaymentMessageHandler {
+func NewPaymentMessageHandler(service *service.PaymentService, log *slog.Logger) *PaymentMessageHandler {
 	return &PaymentMessageHandler{
-		service:  service,
-		producer: producer,
-		log:      log,
+		service: service,
+		log:     log,
 	}
 }
 
-func (h *PaymentMessageHandler) HandlePaymentRequest(ctx context.Context, req PaymentRequestDTO) error {
-	payment, err := h.service.ProcessPayment(ctx, req.BookingID, req.UserID, req.AmountCents, req.Currency)
+func (h *PaymentMessageHandler) HandlePaymentRequest(ctx context.Context, req *eventsv1.PaymentRequest) error {
+	if err := h.service.ProcessPayment(ctx, req.BookingId, req.Reference, req.UserId, req.AmountCents, req.Currency); err != nil {
+		return fmt.Errorf("server processing error: %w", err)
+	}
+
+	return nil
+}
+
+func (h *PaymentMessageHandler) HandleRefundRequest(ctx context.Context, req *eventsv1.RefundRequest) error {
+	_, err := h.service.ProcessRefund(ctx, domain.RefundRequest{
+		BookingID:   req.BookingId,
+		RefundKey:   req.RefundKey,
+		AmountCents: req.AmountCents,
+		Reason:      req.Reason,
+		Full:        req.Full,
+	})
 	if err != nil {
 		return fmt.Errorf("server processing error: %w", err)
 	}
 
-	if err := h.producer.SendPaymentResult(ctx, payment); err != nil {
-		return fmt.Errorf("failed to send result: %w", err)
+	return nil
+}
+
+func (h *PaymentMessageHandler) HandleCaptureRequest(ctx context.Context, req *eventsv1.PaymentCaptureRequest) error {
+	if _, err := h.service.ProcessCapture(ctx, req.BookingId, req.AmountCents); err != nil {
+		return fmt.Errorf("server processing error: %w", err)
+	}
+
+	return nil
+}
+
+func (h *PaymentMessageHandler) HandleVoidRequest(ctx context.Context, req *eventsv1.PaymentVoidRequest) error {
+	if _, err := h.service.ProcessVoid(ctx, req.BookingId, req.Reason); err != nil {
+		return fmt.Errorf("server processing error: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"strings"
+)
+
+const fakeAuthorizationPrefix = "fake_auth_"
+
+// Fake is a deterministic provider driven by test card rules, the outcome of
+// every call depends on its arguments only:
+//
+//   - an amount ending in 13 is declined for insufficient funds,
+//   - an amount ending in 05 is declined by the card issuer,
+//   - the XTS currency (the ISO 4217 testing code) times out,
+//   - refunding an amount ending in 14 is rejected,
+//
+// and everything else succeeds.
+type Fake struct{}
+
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+func (f *Fake) Name() string {
+	return NameFake
+}
+
+func (f *Fake) Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case strings.EqualFold(req.Currency, "XTS"):
+		return nil, ErrTimeout
+	case req.AmountCents%100 == 13:
+		return nil, &DeclineError{Code: DeclineInsufficientFunds, Message: "insufficient funds"}
+	case req.AmountCents%100 == 5:
+		return nil, &DeclineError{Code: DeclineCardDeclined, Message: "do not honor"}
+	}
+
+	return &Authorization{ID: fakeAuthorizationPrefix + req.PaymentID}, nil
+}
+
+func (f *Fake) Capture(ctx context.Context, authorizationID string, amountCents int64) error {
+	return ctx.Err()
+}
+
+func (f *Fake) Void(ctx context.Context, authorizationID string) error {
+	return ctx.Err()
+}
+
+func (f *Fake) VoidPayment(ctx context.Context, paymentID string) error {
+	return ctx.Err()
+}
+
+func (f *Fake) Refund(ctx context.Context, authorizationID, refundID string, amountCents int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if amountCents%100 == 14 {
+		return &DeclineError{Code: DeclineRefundRejected, Message: "refund rejected by issuer"}
+	}
+	return nil
+}
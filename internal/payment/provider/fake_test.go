@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFakeAuthorize(t *testing.T) {
+	tests := []struct {
+		name        string
+		amountCents int64
+		currency    string
+		wantErr     error
+		wantDecline DeclineCode
+	}{
+		{name: "approved", amountCents: 10000, currency: "RUB"},
+		{name: "testing currency times out", amountCents: 10000, currency: "XTS", wantErr: ErrTimeout},
+		{name: "testing currency in lower case", amountCents: 10000, currency: "xts", wantErr: ErrTimeout},
+		{name: "ending in 13", amountCents: 10013, currency: "RUB", wantDecline: DeclineInsufficientFunds},
+		{name: "ending in 05", amountCents: 10005, currency: "RUB", wantDecline: DeclineCardDeclined},
+		{name: "ending in 14 authorizes", amountCents: 10014, currency: "RUB"},
+		{name: "ending in 113", amountCents: 10113, currency: "RUB", wantDecline: DeclineInsufficientFunds},
+		{name: "ending in 50", amountCents: 10050, currency: "RUB"},
+	}
+
+	f := NewFake()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, err := f.Authorize(context.Background(), AuthorizeRequest{
+				PaymentID:   "payment-1",
+				UserID:      1,
+				AmountCents: tt.amountCents,
+				Currency:    tt.currency,
+			})
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authorize() error = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantDecline != "":
+				var decline *DeclineError
+				if !errors.As(err, &decline) || decline.Code != tt.wantDecline {
+					t.Fatalf("Authorize() error = %v, want decline %s", err, tt.wantDecline)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("Authorize() error = %v", err)
+				}
+				if want := fakeAuthorizationPrefix + "payment-1"; auth.ID != want {
+					t.Errorf("Authorize() ID = %q, want %q", auth.ID, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFakeRefund(t *testing.T) {
+	tests := []struct {
+		name        string
+		amountCents int64
+		wantDecline bool
+	}{
+		{name: "refunded", amountCents: 5000},
+		{name: "ending in 14 is rejected", amountCents: 5014, wantDecline: true},
+		{name: "ending in 13 is refunded", amountCents: 5013},
+	}
+
+	f := NewFake()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := f.Refund(context.Background(), "fake_auth_payment-1", "refund-1", tt.amountCents)
+
+			if !tt.wantDecline {
+				if err != nil {
+					t.Fatalf("Refund() error = %v", err)
+				}
+				return
+			}
+
+			var decline *DeclineError
+			if !errors.As(err, &decline) || decline.Code != DeclineRefundRejected {
+				t.Fatalf("Refund() error = %v, want decline %s", err, DeclineRefundRejected)
+			}
+		})
+	}
+}
+
+func TestFakeCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	f := NewFake()
+	if _, err := f.Authorize(ctx, AuthorizeRequest{PaymentID: "payment-1", AmountCents: 10000, Currency: "RUB"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Authorize() error = %v, want %v", err, context.Canceled)
+	}
+	if err := f.Capture(ctx, "fake_auth_payment-1", 10000); !errors.Is(err, context.Canceled) {
+		t.Errorf("Capture() error = %v, want %v", err, context.Canceled)
+	}
+	if err := f.Refund(ctx, "fake_auth_payment-1", "refund-1", 10000); !errors.Is(err, context.Canceled) {
+		t.Errorf("Refund() error = %v, want %v", err, context.Canceled)
+	}
+}
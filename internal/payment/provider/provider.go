@@ -0,0 +1,89 @@
+// Package provider abstracts the acquirer the payment service charges
+// through. A payment is authorized first, which only holds the money, and
+// then captured; an authorization that is not captured is voided.
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+const (
+	NameFake      = "fake"
+	NameSimulator = "simulator"
+)
+
+type Provider interface {
+	// Name is stored with every payment to record who authorized it. Captures,
+	// voids and refunds all go to the one configured provider, switching
+	// providers strands the payments authorized by the previous one.
+	Name() string
+
+	Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error)
+	Capture(ctx context.Context, authorizationID string, amountCents int64) error
+	Void(ctx context.Context, authorizationID string) error
+	// VoidPayment voids the authorization made for a payment, if there is
+	// one. It releases the hold of an authorization whose outcome was never
+	// learnt, the payment ID being its idempotency key.
+	VoidPayment(ctx context.Context, paymentID string) error
+	// Refund gives back part of a captured payment, refundID is the
+	// idempotency key of the refund.
+	Refund(ctx context.Context, authorizationID, refundID string, amountCents int64) error
+}
+
+type AuthorizeRequest struct {
+	// PaymentID doubles as idempotency key, authorizing the same payment
+	// twice returns the same authorization.
+	PaymentID   string
+	UserID      int64
+	AmountCents int64
+	Currency    string
+}
+
+type Authorization struct {
+	// ID is the provider's reference, captures, voids and refunds use it.
+	ID string
+}
+
+// ErrTimeout means the provider did not answer in time, the outcome of the
+// call is unknown.
+var ErrTimeout = errors.New("payment provider timed out")
+
+type DeclineCode string
+
+const (
+	DeclineInsufficientFunds DeclineCode = "insufficient_funds"
+	DeclineCardDeclined      DeclineCode = "card_declined"
+	DeclineRefundRejected    DeclineCode = "refund_rejected"
+)
+
+// DeclineError is a definite refusal by the provider, retrying the same call
+// gets the same answer.
+type DeclineError struct {
+	Code    DeclineCode
+	Message string
+}
+
+func (e *DeclineError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// IsDeclined reports whether err is a refusal by the provider.
+func IsDeclined(err error) bool {
+	var decline *DeclineError
+	return errors.As(err, &decline)
+}
+
+// New returns the provider configured by name.
+func New(name string, log *slog.Logger) (Provider, error) {
+	switch name {
+	case NameFake:
+		return NewFake(), nil
+	case NameSimulator:
+		return NewSimulator(log), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", name)
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"math/big"
+	"time"
+)
+
+const (
+	ProbabilityBase   = 100
+	BankSuccessChance = 80
+	BankMinDelay      = 500 * time.Millisecond
+	BankMaxDelay      = 2000 * time.Millisecond
+)
+
+const simulatorAuthorizationPrefix = "sim_auth_"
+
+// Simulator imitates a real bank: every call takes a random 0.5-2s and
+// authorizations are declined at random.
+type Simulator struct {
+	log *slog.Logger
+}
+
+func NewSimulator(log *slog.Logger) *Simulator {
+	return &Simulator{log: log}
+}
+
+func (s *Simulator) Name() string {
+	return NameSimulator
+}
+
+func (s *Simulator) Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error) {
+	if err := s.simulateBankLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	if !s.isBankSuccessful() {
+		return nil, &DeclineError{Code: DeclineInsufficientFunds, Message: "insufficient funds or bank error"}
+	}
+	return &Authorization{ID: simulatorAuthorizationPrefix + req.PaymentID}, nil
+}
+
+func (s *Simulator) Capture(ctx context.Context, authorizationID string, amountCents int64) error {
+	return s.simulateBankLatency(ctx)
+}
+
+func (s *Simulator) Void(ctx context.Context, authorizationID string) error {
+	return s.simulateBankLatency(ctx)
+}
+
+func (s *Simulator) VoidPayment(ctx context.Context, paymentID string) error {
+	return s.simulateBankLatency(ctx)
+}
+
+func (s *Simulator) Refund(ctx context.Context, authorizationID, refundID string, amountCents int64) error {
+	return s.simulateBankLatency(ctx)
+}
+
+func (s *Simulator) simulateBankLatency(ctx context.Context) error {
+	delay := BankMinDelay
+	if delta := int64(BankMaxDelay - BankMinDelay); delta > 0 {
+		delay += time.Duration(s.mustCryptoRandInt64(delta))
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (s *Simulator) isBankSuccessful() bool {
+	val := s.mustCryptoRandInt64(ProbabilityBase)
+	return val < int64(BankSuccessChance)
+}
+
+func (s *Simulator) mustCryptoRandInt64(max int64) int64 {
+	n, err := rand.Int(rand.Reader, big.NewInt(max))
+	if err != nil {
+		s.log.Error("crypto/rand failed", "error", err)
+		return 0
+	}
+	return n.Int64()
+}
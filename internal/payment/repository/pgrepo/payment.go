@@ -6,76 +6,174 @@ import (
 	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
 	"github.com/squ1ky/flyte/internal/payment/domain"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"github.com/squ1ky/flyte/pkg/inbox"
+	"github.com/squ1ky/flyte/pkg/outbox"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 	"time"
 )
 
+// InboxTable holds the IDs of the consumed requests that created or
+// refunded a payment.
+const InboxTable = "payment_inbox"
+
+// OutboxTable holds the results of payment, refund, capture and void
+// requests, the outbox relay publishes them.
+const OutboxTable = "payment_outbox"
+
 type PaymentRepo struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	inbox  *inbox.Inbox
+	outbox *outbox.Writer
 }
 
 func NewPaymentRepo(db *sqlx.DB) *PaymentRepo {
-	return &PaymentRepo{db: db}
+	return &PaymentRepo{
+		db:     db,
+		inbox:  inbox.New(InboxTable),
+		outbox: outbox.NewWriter(OutboxTable),
+	}
 }
 
+// CreateOrGet inserts a pending payment, or returns the existing one when
+// the booking already has a payment with the same reference or the request
+// was already processed.
 func (r *PaymentRepo) CreateOrGet(ctx context.Context, p *domain.Payment) (*domain.CreatePaymentResult, error) {
 	if p.Status == "" {
 		p.Status = domain.PaymentStatusPending
 	}
 	now := time.Now()
 
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	created, err := r.create(ctx, tx, p, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if created {
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit tx: %w", err)
+		}
+
+		return &domain.CreatePaymentResult{
+			Payment: p,
+			IsNew:   true,
+		}, nil
+	}
+
+	existingPayment, err := r.getByReference(ctx, p.BookingID, p.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CreatePaymentResult{
+		Payment: existingPayment,
+		IsNew:   false,
+	}, nil
+}
+
+func (r *PaymentRepo) create(ctx context.Context, tx *sqlx.Tx, p *domain.Payment, now time.Time) (bool, error) {
+	if err := r.inbox.Record(ctx, tx, ""); err != nil {
+		if errors.Is(err, inbox.ErrDuplicate) {
+			return false, nil
+		}
+		return false, err
+	}
+
 	insertQuery := `
-		INSERT INTO payments (booking_id, user_id, amount_cents, currency, status, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		ON CONFLICT (booking_id) DO NOTHING
+		INSERT INTO payments (booking_id, reference, user_id, amount_cents, currency, status, provider, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (booking_id, reference) DO NOTHING
 		RETURNING id, created_at
 	`
 
-	var createdID string
-	var createdAt time.Time
-
-	err := r.db.QueryRowContext(ctx, insertQuery,
+	err := tx.QueryRowContext(ctx, insertQuery,
 		p.BookingID,
+		p.Reference,
 		p.UserID,
 		p.AmountCents,
 		p.Currency,
 		p.Status,
+		p.Provider,
 		now,
-	).Scan(&createdID, &createdAt)
+	).Scan(&p.ID, &p.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
 
-	if err == nil {
-		p.ID = createdID
-		p.CreatedAt = createdAt
+	return true, nil
+}
 
-		return &domain.CreatePaymentResult{
-			Payment: p,
-			IsNew:   true,
-		}, nil
+// UpdateStatus moves a payment from one status to another and queues the
+// result of the request that moved it in the same transaction. It fails with
+// ErrStatusChanged when the payment is no longer in from.
+func (r *PaymentRepo) UpdateStatus(ctx context.Context, paymentID string, from, to domain.PaymentStatus, errorMsg *string, result *domain.ActionResult) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
 	}
+	defer tx.Rollback()
 
-	if !errors.Is(err, sql.ErrNoRows) {
-		return nil, err
+	query := `
+		UPDATE payments
+		SET status = $1, error_message = $2, processed_at = NOW()
+		WHERE id = $3 AND status = $4
+	`
+
+	res, err := tx.ExecContext(ctx, query, to, errorMsg, paymentID, from)
+	if err != nil {
+		return fmt.Errorf("failed to execute update: %w", err)
 	}
 
-	existingPayment, err := r.GetByBookingID(ctx, p.BookingID)
+	rows, err := res.RowsAffected()
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	return &domain.CreatePaymentResult{
-		Payment: existingPayment,
-		IsNew:   false,
-	}, nil
+	if rows == 0 {
+		return fmt.Errorf("payment %s is not %s: %w", paymentID, from, domain.ErrStatusChanged)
+	}
+
+	if err := r.insertActionResult(ctx, tx, result); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
 }
 
-func (r *PaymentRepo) UpdateStatus(ctx context.Context, paymentID string, status domain.PaymentStatus, errorMsg *string) error {
+// CapturePayment moves an authorized payment to SUCCESS with the amount that
+// was captured, which may be less than the authorized one, and queues the
+// capture result in the same transaction. It fails with ErrStatusChanged when
+// the payment is no longer authorized.
+func (r *PaymentRepo) CapturePayment(ctx context.Context, paymentID string, amountCents int64, result *domain.ActionResult) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE payments
-		SET status = $1, error_message = $2, processed_at = NOW()
-		WHERE id = $3
+		SET status = $1, amount_cents = $2, error_message = NULL, processed_at = NOW()
+		WHERE id = $3 AND status = $4
 	`
 
-	res, err := r.db.ExecContext(ctx, query, status, errorMsg, paymentID)
+	res, err := tx.ExecContext(ctx, query, domain.PaymentStatusSuccess, amountCents, paymentID, domain.PaymentStatusAuthorized)
 	if err != nil {
 		return fmt.Errorf("failed to execute update: %w", err)
 	}
@@ -86,21 +184,163 @@ func (r *PaymentRepo) UpdateStatus(ctx context.Context, paymentID string, status
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("payment with id %s not found for update", paymentID)
+		return fmt.Errorf("payment %s is not %s: %w", paymentID, domain.PaymentStatusAuthorized, domain.ErrStatusChanged)
+	}
+
+	if err := r.insertActionResult(ctx, tx, result); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
 	}
 
 	return nil
 }
 
-func (r *PaymentRepo) GetByBookingID(ctx context.Context, bookingID string) (*domain.Payment, error) {
+// CompletePayment moves a pending payment to its outcome and queues the
+// payment result in the same transaction, so the result is published even if
+// the service stops right after. It fails with ErrStatusChanged when the
+// payment is no longer pending.
+func (r *PaymentRepo) CompletePayment(ctx context.Context, p *domain.Payment, to domain.PaymentStatus, errorMsg *string) error {
+	now := time.Now()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		SELECT id, booking_id, user_id, amount_cents, currency, status, error_message, created_at, processed_at
-		FROM payments
-		WHERE booking_id = $1
+		UPDATE payments
+		SET status = $1, error_message = $2, processed_at = $3
+		WHERE id = $4 AND status = $5
 	`
 
+	res, err := tx.ExecContext(ctx, query, to, errorMsg, now, p.ID, domain.PaymentStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to execute update: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("payment %s is not %s: %w", p.ID, domain.PaymentStatusPending, domain.ErrStatusChanged)
+	}
+
+	result := &eventsv1.PaymentResult{
+		BookingId:   p.BookingID,
+		PaymentId:   p.ID,
+		Reference:   p.Reference,
+		Status:      string(to),
+		ProcessedAt: timestamppb.New(now),
+	}
+	if errorMsg != nil {
+		result.ErrorMessage = *errorMsg
+	}
+
+	if err := r.outbox.Write(ctx, tx, eventcodec.EventTypePaymentResult, result); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	p.Status = to
+	p.ErrorMessage = errorMsg
+	p.ProcessedAt = &now
+
+	return nil
+}
+
+// ClaimStuckPayments returns the payments left pending for longer than
+// stuckAfter, oldest first, and counts a recovery attempt for each. A claimed
+// payment is not returned again before stuckAfter has passed once more.
+func (r *PaymentRepo) ClaimStuckPayments(ctx context.Context, stuckAfter time.Duration, limit int) ([]domain.Payment, error) {
+	query := fmt.Sprintf(`
+		UPDATE payments
+		SET recovery_attempts = recovery_attempts + 1, recovered_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM payments
+			WHERE status = $1
+			  AND COALESCE(recovered_at, created_at) <= NOW() - $2 * INTERVAL '1 millisecond'
+			ORDER BY created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING %s
+	`, paymentColumns)
+
+	var payments []domain.Payment
+	if err := r.db.SelectContext(ctx, &payments, query, domain.PaymentStatusPending, stuckAfter.Milliseconds(), limit); err != nil {
+		return nil, fmt.Errorf("failed to claim stuck payments: %w", err)
+	}
+
+	return payments, nil
+}
+
+// SetProviderRef stores the provider's authorization of a payment as soon as
+// it is made, so the payment can be captured or voided later.
+func (r *PaymentRepo) SetProviderRef(ctx context.Context, paymentID, providerRef string) error {
+	query := `UPDATE payments SET provider_ref = $1 WHERE id = $2`
+
+	res, err := r.db.ExecContext(ctx, query, providerRef, paymentID)
+	if err != nil {
+		return fmt.Errorf("failed to set provider ref: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("payment %s: %w", paymentID, domain.ErrPaymentNotFound)
+	}
+
+	return nil
+}
+
+const paymentColumns = `
+	id, booking_id, reference, user_id, amount_cents, currency, status, error_message,
+	provider, provider_ref, created_at, processed_at, refunded_at, refunded_cents,
+	recovery_attempts
+`
+
+func (r *PaymentRepo) GetByID(ctx context.Context, id string) (*domain.Payment, error) {
+	query := fmt.Sprintf(`SELECT %s FROM payments WHERE id = $1`, paymentColumns)
+
 	var p domain.Payment
-	if err := r.db.GetContext(ctx, &p, query, bookingID); err != nil {
+	if err := r.db.GetContext(ctx, &p, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("payment %s: %w", id, domain.ErrPaymentNotFound)
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return &p, nil
+}
+
+// GetByBookingID returns the initial payment of a booking, additional
+// charges are only available through ListByBookingID.
+func (r *PaymentRepo) GetByBookingID(ctx context.Context, bookingID string) (*domain.Payment, error) {
+	return r.getByReference(ctx, bookingID, "")
+}
+
+func (r *PaymentRepo) getByReference(ctx context.Context, bookingID, reference string) (*domain.Payment, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM payments
+		WHERE booking_id = $1 AND reference = $2
+	`, paymentColumns)
+
+	var p domain.Payment
+	if err := r.db.GetContext(ctx, &p, query, bookingID, reference); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrPaymentNotFound
 		}
@@ -109,3 +349,68 @@ func (r *PaymentRepo) GetByBookingID(ctx context.Context, bookingID string) (*do
 
 	return &p, nil
 }
+
+func (r *PaymentRepo) ListByBookingID(ctx context.Context, bookingID string) ([]domain.Payment, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM payments
+		WHERE booking_id = $1
+		ORDER BY created_at
+	`, paymentColumns)
+
+	var payments []domain.Payment
+	if err := r.db.SelectContext(ctx, &payments, query, bookingID); err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+
+	return payments, nil
+}
+
+// List returns the payments matching the filter, newest first.
+func (r *PaymentRepo) List(ctx context.Context, f domain.PaymentFilter) ([]domain.Payment, error) {
+	where := []string{"TRUE"}
+	var args []interface{}
+
+	if f.UserID > 0 {
+		where = append(where, "user_id = ?")
+		args = append(args, f.UserID)
+	}
+	if len(f.Statuses) > 0 {
+		where = append(where, "status IN (?)")
+		args = append(args, f.Statuses)
+	}
+	if f.CreatedFrom != nil {
+		where = append(where, "created_at >= ?")
+		args = append(args, *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		where = append(where, "created_at < ?")
+		args = append(args, *f.CreatedTo)
+	}
+	if f.After != nil {
+		where = append(where, "(created_at, id) < (?, ?)")
+		args = append(args, f.After.CreatedAt, f.After.ID)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM payments WHERE %s ORDER BY created_at DESC, id DESC LIMIT ?",
+		paymentColumns, strings.Join(where, " AND "),
+	)
+	args = append(args, f.Limit)
+
+	query, args, err := sqlx.In(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build payments query: %w", err)
+	}
+
+	var payments []domain.Payment
+	if err := r.db.SelectContext(ctx, &payments, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+
+	if payments == nil {
+		payments = []domain.Payment{}
+	}
+
+	return payments, nil
+}
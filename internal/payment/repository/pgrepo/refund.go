@@ -0,0 +1,158 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+)
+
+const refundColumns = `
+	id, payment_id, booking_id, refund_key, amount_cents, currency, status, reason, error_message,
+	created_at, processed_at
+`
+
+// CreateRefunds stores the pending refunds of one request and reserves their
+// amounts on the payments. It fails with ErrRefundExists when the refund key
+// was stored meanwhile and with ErrRefundExceeded when a payment has not that
+// much left to refund.
+func (r *PaymentRepo) CreateRefunds(ctx context.Context, refunds []domain.Refund) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	reserveQuery := `
+		UPDATE payments
+		SET refunded_cents = refunded_cents + $1
+		WHERE id = $2 AND status = $3 AND refunded_cents + $1 <= amount_cents
+	`
+	insertQuery := `
+		INSERT INTO refunds (payment_id, booking_id, refund_key, amount_cents, currency, status, reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (refund_key, payment_id) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	for i := range refunds {
+		rf := &refunds[i]
+
+		res, err := tx.ExecContext(ctx, reserveQuery, rf.AmountCents, rf.PaymentID, domain.PaymentStatusSuccess)
+		if err != nil {
+			return fmt.Errorf("failed to reserve refund amount: %w", err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("payment %s: %w", rf.PaymentID, domain.ErrRefundExceeded)
+		}
+
+		err = tx.QueryRowContext(ctx, insertQuery,
+			rf.PaymentID, rf.BookingID, rf.RefundKey, rf.AmountCents, rf.Currency, rf.Status, rf.Reason,
+		).Scan(&rf.ID, &rf.CreatedAt)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("refund key %s: %w", rf.RefundKey, domain.ErrRefundExists)
+			}
+			return fmt.Errorf("failed to insert refund: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteRefund records the provider's answer to a pending refund. A failed
+// refund gives its amount back to the payment, a payment refunded in full
+// becomes REFUNDED. The result of the refund request is queued in the same
+// transaction when one is given, that is when the last refund completes.
+func (r *PaymentRepo) CompleteRefund(ctx context.Context, rf *domain.Refund, status domain.RefundStatus, errorMsg *string, result *domain.RefundResult) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	refundQuery := `
+		UPDATE refunds
+		SET status = $1, error_message = $2, processed_at = NOW()
+		WHERE id = $3 AND status = $4
+		RETURNING processed_at
+	`
+	err = tx.QueryRowContext(ctx, refundQuery, status, errorMsg, rf.ID, domain.RefundStatusPending).Scan(&rf.ProcessedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("refund %s is not %s: %w", rf.ID, domain.RefundStatusPending, domain.ErrStatusChanged)
+		}
+		return fmt.Errorf("failed to complete refund: %w", err)
+	}
+
+	var paymentQuery string
+	var args []interface{}
+	switch status {
+	case domain.RefundStatusSuccess:
+		paymentQuery = `
+			UPDATE payments
+			SET status = $1, refunded_at = NOW()
+			WHERE id = $2 AND status = $3 AND refunded_cents = amount_cents
+			  AND NOT EXISTS (SELECT 1 FROM refunds WHERE payment_id = $2 AND status = $4)
+		`
+		args = []interface{}{domain.PaymentStatusRefunded, rf.PaymentID, domain.PaymentStatusSuccess, domain.RefundStatusPending}
+	default:
+		paymentQuery = `
+			UPDATE payments
+			SET refunded_cents = refunded_cents - $1
+			WHERE id = $2
+		`
+		args = []interface{}{rf.AmountCents, rf.PaymentID}
+	}
+	if _, err := tx.ExecContext(ctx, paymentQuery, args...); err != nil {
+		return fmt.Errorf("failed to update refunded payment: %w", err)
+	}
+
+	if result != nil {
+		if err := r.insertRefundResult(ctx, tx, result); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	rf.Status = status
+	rf.ErrorMessage = errorMsg
+	return nil
+}
+
+// ListRefundsByKey returns the refunds stored for one refund request.
+func (r *PaymentRepo) ListRefundsByKey(ctx context.Context, refundKey string) ([]domain.Refund, error) {
+	query := fmt.Sprintf(`SELECT %s FROM refunds WHERE refund_key = $1 ORDER BY created_at, id`, refundColumns)
+
+	var refunds []domain.Refund
+	if err := r.db.SelectContext(ctx, &refunds, query, refundKey); err != nil {
+		return nil, fmt.Errorf("failed to list refunds: %w", err)
+	}
+
+	return refunds, nil
+}
+
+// ListRefundsByBooking returns the refunds of every payment of a booking,
+// oldest first.
+func (r *PaymentRepo) ListRefundsByBooking(ctx context.Context, bookingID string) ([]domain.Refund, error) {
+	query := fmt.Sprintf(`SELECT %s FROM refunds WHERE booking_id = $1 ORDER BY created_at, id`, refundColumns)
+
+	refunds := []domain.Refund{}
+	if err := r.db.SelectContext(ctx, &refunds, query, bookingID); err != nil {
+		return nil, fmt.Errorf("failed to list refunds: %w", err)
+	}
+
+	return refunds, nil
+}
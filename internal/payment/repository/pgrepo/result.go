@@ -0,0 +1,79 @@
+package pgrepo
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+	"github.com/squ1ky/flyte/pkg/eventcodec"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QueueActionResult queues the answer to a capture or void request that
+// changed nothing, such as a rejection or a repeated request.
+func (r *PaymentRepo) QueueActionResult(ctx context.Context, result *domain.ActionResult) error {
+	return r.queue(ctx, func(tx *sqlx.Tx) error {
+		return r.insertActionResult(ctx, tx, result)
+	})
+}
+
+// QueueRefundResult queues the answer to a refund request that has no
+// pending refund left to complete.
+func (r *PaymentRepo) QueueRefundResult(ctx context.Context, result *domain.RefundResult) error {
+	return r.queue(ctx, func(tx *sqlx.Tx) error {
+		return r.insertRefundResult(ctx, tx, result)
+	})
+}
+
+func (r *PaymentRepo) queue(ctx context.Context, insert func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insert(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepo) insertActionResult(ctx context.Context, tx *sqlx.Tx, result *domain.ActionResult) error {
+	var eventType string
+	switch result.Action {
+	case domain.PaymentActionCapture:
+		eventType = eventcodec.EventTypePaymentCaptureResult
+	case domain.PaymentActionVoid:
+		eventType = eventcodec.EventTypePaymentVoidResult
+	default:
+		return fmt.Errorf("unknown payment action %q", result.Action)
+	}
+
+	payload := &eventsv1.PaymentResult{
+		BookingId:    result.BookingID,
+		PaymentId:    result.PaymentID,
+		Status:       string(result.Status),
+		ErrorMessage: result.ErrorMessage,
+		ProcessedAt:  timestamppb.Now(),
+	}
+	return r.outbox.Write(ctx, tx, eventType, payload)
+}
+
+func (r *PaymentRepo) insertRefundResult(ctx context.Context, tx *sqlx.Tx, result *domain.RefundResult) error {
+	payload := &eventsv1.PaymentResult{
+		BookingId:    result.BookingID,
+		PaymentId:    result.PaymentID,
+		Status:       string(result.Status),
+		ErrorMessage: result.ErrorMessage,
+		ProcessedAt:  timestamppb.Now(),
+		RefundKey:    result.RefundKey,
+		AmountCents:  result.AmountCents,
+	}
+	return r.outbox.Write(ctx, tx, eventcodec.EventTypeRefundResult, payload)
+}
@@ -3,10 +3,26 @@ package repository
 import (
 	"context"
 	"github.com/squ1ky/flyte/internal/payment/domain"
+	"time"
 )
 
 type PaymentRepository interface {
 	CreateOrGet(ctx context.Context, payment *domain.Payment) (*domain.CreatePaymentResult, error)
-	UpdateStatus(ctx context.Context, paymentID string, status domain.PaymentStatus, errorMessage *string) error
+	UpdateStatus(ctx context.Context, paymentID string, from, to domain.PaymentStatus, errorMessage *string, result *domain.ActionResult) error
+	CapturePayment(ctx context.Context, paymentID string, amountCents int64, result *domain.ActionResult) error
+	CompletePayment(ctx context.Context, payment *domain.Payment, to domain.PaymentStatus, errorMessage *string) error
+	ClaimStuckPayments(ctx context.Context, stuckAfter time.Duration, limit int) ([]domain.Payment, error)
+	SetProviderRef(ctx context.Context, paymentID, providerRef string) error
+	GetByID(ctx context.Context, id string) (*domain.Payment, error)
 	GetByBookingID(ctx context.Context, bookingID string) (*domain.Payment, error)
+	ListByBookingID(ctx context.Context, bookingID string) ([]domain.Payment, error)
+	List(ctx context.Context, filter domain.PaymentFilter) ([]domain.Payment, error)
+
+	CreateRefunds(ctx context.Context, refunds []domain.Refund) error
+	CompleteRefund(ctx context.Context, refund *domain.Refund, status domain.RefundStatus, errorMessage *string, result *domain.RefundResult) error
+	ListRefundsByKey(ctx context.Context, refundKey string) ([]domain.Refund, error)
+	ListRefundsByBooking(ctx context.Context, bookingID string) ([]domain.Refund, error)
+
+	QueueActionResult(ctx context.Context, result *domain.ActionResult) error
+	QueueRefundResult(ctx context.Context, result *domain.RefundResult) error
 }
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+func (s *PaymentService) GetPayment(ctx context.Context, id string, caller domain.Caller) (*domain.Payment, error) {
+	payment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !caller.CanAccess(payment) {
+		s.log.Warn("access to foreign payment denied", "payment_id", id, "caller_id", caller.UserID)
+		return nil, fmt.Errorf("payment %s: %w", id, domain.ErrPaymentAccessDenied)
+	}
+
+	return payment, nil
+}
+
+// GetPaymentByBooking returns the initial payment of a booking, the
+// additional charges made after it and the refunds of all of them.
+func (s *PaymentService) GetPaymentByBooking(
+	ctx context.Context,
+	bookingID string,
+	caller domain.Caller,
+) (*domain.Payment, []domain.Payment, []domain.Refund, error) {
+	payments, err := s.repo.ListByBookingID(ctx, bookingID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var initial *domain.Payment
+	charges := make([]domain.Payment, 0, len(payments))
+	for i := range payments {
+		if payments[i].Reference == "" {
+			initial = &payments[i]
+			continue
+		}
+		charges = append(charges, payments[i])
+	}
+	if initial == nil {
+		return nil, nil, nil, fmt.Errorf("booking %s: %w", bookingID, domain.ErrPaymentNotFound)
+	}
+
+	if !caller.CanAccess(initial) {
+		s.log.Warn("access to foreign payment denied", "booking_id", bookingID, "caller_id", caller.UserID)
+		return nil, nil, nil, fmt.Errorf("booking %s: %w", bookingID, domain.ErrPaymentAccessDenied)
+	}
+
+	refunds, err := s.repo.ListRefundsByBooking(ctx, bookingID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return initial, charges, refunds, nil
+}
+
+// ListPayments returns one page of payments and the cursor of the next page,
+// which is nil on the last page. Users only list their own payments, admins
+// list any user's or, without a user, everybody's.
+func (s *PaymentService) ListPayments(
+	ctx context.Context,
+	caller domain.Caller,
+	filter domain.PaymentFilter,
+) ([]domain.Payment, *domain.PaymentCursor, error) {
+	if !caller.IsAdmin() {
+		if filter.UserID != 0 && filter.UserID != caller.UserID {
+			s.log.Warn("listing foreign payments denied", "user_id", filter.UserID, "caller_id", caller.UserID)
+			return nil, nil, fmt.Errorf("user %d: %w", filter.UserID, domain.ErrPaymentAccessDenied)
+		}
+		filter.UserID = caller.UserID
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultPageSize
+	}
+	if filter.Limit > MaxPageSize {
+		filter.Limit = MaxPageSize
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+
+	payments, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(payments) <= pageSize {
+		return payments, nil, nil
+	}
+
+	payments = payments[:pageSize]
+	return payments, domain.CursorFor(&payments[pageSize-1]), nil
+}
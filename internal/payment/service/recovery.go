@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+)
+
+// RecoverPayments resumes payments stuck in PENDING, their request was
+// acknowledged while the payment was left unfinished. It returns how many
+// payments it claimed.
+func (s *PaymentService) RecoverPayments(ctx context.Context, limit int) (int, error) {
+	payments, err := s.repo.ClaimStuckPayments(ctx, s.cfg.Recovery.StuckAfter, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range payments {
+		p := &payments[i]
+		if err := s.recoverPayment(ctx, p); err != nil {
+			s.log.Warn("failed to recover payment, will retry",
+				"payment_id", p.ID,
+				"booking_id", p.BookingID,
+				"attempts", p.RecoveryAttempts,
+				"error", err)
+		}
+	}
+
+	return len(payments), nil
+}
+
+// recoverPayment settles a stuck payment again, the provider's authorization
+// is idempotent per payment. A payment that keeps getting stuck is failed and
+// its authorization released, known or not.
+func (s *PaymentService) recoverPayment(ctx context.Context, p *domain.Payment) error {
+	log := s.log.With("payment_id", p.ID, "booking_id", p.BookingID, "attempts", p.RecoveryAttempts)
+
+	var err error
+	if p.RecoveryAttempts > s.cfg.Recovery.MaxAttempts {
+		log.Warn("payment stuck too long, failing it")
+		if p.ProviderRef != nil {
+			s.void(ctx, log, *p.ProviderRef)
+		} else if err := s.provider.VoidPayment(ctx, p.ID); err != nil {
+			// An authorization whose outcome was never learnt may still hold
+			// the money, the payment is not failed before it is released.
+			return err
+		}
+		msg := "payment could not be processed"
+		err = s.repo.CompletePayment(ctx, p, domain.PaymentStatusFailed, &msg)
+	} else {
+		log.Info("resuming stuck payment")
+		err = s.settle(ctx, p)
+	}
+
+	if errors.Is(err, domain.ErrStatusChanged) {
+		log.Info("payment was finished concurrently")
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+	"github.com/squ1ky/flyte/internal/payment/provider"
+	"strings"
+)
+
+// ProcessRefund refunds part or all of a booking's captured payments, the
+// initial payment first and additional charges after it. A request is stored
+// before the provider is called, so repeating it with the same refund key
+// resumes the refunds still pending and never refunds twice. The result is
+// published through the outbox.
+func (s *PaymentService) ProcessRefund(ctx context.Context, req domain.RefundRequest) (*domain.RefundResult, error) {
+	// Requests made before refund keys refunded a booking in full, once.
+	legacy := req.RefundKey == ""
+	if legacy {
+		req.RefundKey = req.BookingID
+	}
+	log := s.log.With("booking_id", req.BookingID, "refund_key", req.RefundKey)
+
+	refunds, err := s.repo.ListRefundsByKey(ctx, req.RefundKey)
+	if err != nil {
+		log.Error("failed to get refunds", "error", err)
+		return nil, err
+	}
+
+	if len(refunds) == 0 {
+		var rejected *domain.RefundResult
+		refunds, rejected, err = s.createRefunds(ctx, req)
+		if err != nil {
+			log.Error("failed to create refunds", "error", err)
+			return nil, err
+		}
+		if rejected != nil {
+			log.Warn("refund rejected", "reason", rejected.ErrorMessage)
+			return s.answerRefund(ctx, rejected)
+		}
+	} else {
+		log.Info("refund request duplicate, resuming stored refunds")
+	}
+
+	// A full refund's amount depends on what was refundable when it was made.
+	if err := checkRefundRequest(req, refunds); err != nil && !legacy && !req.Full {
+		log.Warn("refund rejected", "reason", err)
+		return s.answerRefund(ctx, rejectRefund(req, err.Error()))
+	}
+
+	queued, err := s.completeRefunds(ctx, req, refunds)
+	if err != nil {
+		return nil, err
+	}
+
+	result := refundResult(req, refunds)
+	if !queued {
+		// Nothing was left to complete, the request is answered again.
+		return s.answerRefund(ctx, result)
+	}
+	return result, nil
+}
+
+// answerRefund queues the result of a refund request that completed no
+// refund.
+func (s *PaymentService) answerRefund(ctx context.Context, result *domain.RefundResult) (*domain.RefundResult, error) {
+	if err := s.repo.QueueRefundResult(ctx, result); err != nil {
+		s.log.Error("failed to queue refund result", "error", err, "booking_id", result.BookingID)
+		return nil, err
+	}
+	return result, nil
+}
+
+// RefundPayment refunds a booking on behalf of an admin, see ProcessRefund.
+func (s *PaymentService) RefundPayment(ctx context.Context, caller domain.Caller, req domain.RefundRequest) (*domain.RefundResult, error) {
+	if !caller.IsAdmin() {
+		s.log.Warn("refund by non-admin denied", "booking_id", req.BookingID, "caller_id", caller.UserID)
+		return nil, domain.ErrAdminOnly
+	}
+
+	return s.ProcessRefund(ctx, req)
+}
+
+// createRefunds spreads the requested amount over the booking's captured
+// payments and stores the refunds, a full refund takes whatever is still
+// refundable. A request that cannot be refunded gets a rejected result
+// instead.
+func (s *PaymentService) createRefunds(ctx context.Context, req domain.RefundRequest) ([]domain.Refund, *domain.RefundResult, error) {
+	if req.AmountCents <= 0 && !req.Full {
+		return nil, rejectRefund(req, "refund amount must be positive"), nil
+	}
+
+	payments, err := s.repo.ListByBookingID(ctx, req.BookingID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(payments) == 0 {
+		return nil, rejectRefund(req, domain.ErrPaymentNotFound.Error()), nil
+	}
+
+	if req.Full {
+		req.AmountCents = 0
+		for _, p := range payments {
+			req.AmountCents += p.RefundableCents()
+		}
+		// Everything was refunded already, there is nothing left to do.
+		if req.AmountCents == 0 {
+			return nil, nil, nil
+		}
+	}
+
+	var reason *string
+	if req.Reason != "" {
+		reason = &req.Reason
+	}
+
+	var refunds []domain.Refund
+	var refundable int64
+	left := req.AmountCents
+	for _, p := range payments {
+		available := p.RefundableCents()
+		refundable += available
+		if available == 0 || left == 0 {
+			continue
+		}
+
+		amount := min(available, left)
+		left -= amount
+		refunds = append(refunds, domain.Refund{
+			PaymentID:   p.ID,
+			BookingID:   p.BookingID,
+			RefundKey:   req.RefundKey,
+			AmountCents: amount,
+			Currency:    p.Currency,
+			Status:      domain.RefundStatusPending,
+			Reason:      reason,
+		})
+	}
+
+	if left > 0 {
+		msg := fmt.Sprintf("refund amount %d exceeds refundable amount %d", req.AmountCents, refundable)
+		return nil, rejectRefund(req, msg), nil
+	}
+
+	err = s.repo.CreateRefunds(ctx, refunds)
+	switch {
+	case errors.Is(err, domain.ErrRefundExists):
+		// The same request is being processed concurrently.
+		refunds, err = s.repo.ListRefundsByKey(ctx, req.RefundKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		return refunds, nil, nil
+	case errors.Is(err, domain.ErrRefundExceeded):
+		return nil, rejectRefund(req, err.Error()), nil
+	case err != nil:
+		return nil, nil, err
+	}
+
+	return refunds, nil, nil
+}
+
+// completeRefunds asks the provider for every pending refund. A refund the
+// provider could not be asked for stays pending and the error is returned,
+// the request is retried then. The last refund to complete queues the
+// request's result, completeRefunds reports whether it did.
+func (s *PaymentService) completeRefunds(ctx context.Context, req domain.RefundRequest, refunds []domain.Refund) (bool, error) {
+	last := -1
+	for i := range refunds {
+		if refunds[i].Status == domain.RefundStatusPending {
+			last = i
+		}
+	}
+
+	for i := range refunds {
+		rf := &refunds[i]
+		if rf.Status != domain.RefundStatusPending {
+			continue
+		}
+		log := s.log.With("booking_id", rf.BookingID, "payment_id", rf.PaymentID, "refund_id", rf.ID)
+
+		payment, err := s.repo.GetByID(ctx, rf.PaymentID)
+		if err != nil {
+			log.Error("failed to get refunded payment", "error", err)
+			return false, err
+		}
+
+		status := domain.RefundStatusSuccess
+		var errorMsg *string
+		// Payments charged before providers were tracked have nothing to call.
+		if payment.ProviderRef != nil {
+			if err := s.provider.Refund(ctx, *payment.ProviderRef, rf.ID, rf.AmountCents); err != nil {
+				if !provider.IsDeclined(err) {
+					log.Error("failed to refund payment", "error", err)
+					return false, err
+				}
+				status = domain.RefundStatusFailed
+				msg := err.Error()
+				errorMsg = &msg
+			}
+		}
+
+		var result *domain.RefundResult
+		if i == last {
+			done := append([]domain.Refund(nil), refunds...)
+			done[i].Status = status
+			done[i].ErrorMessage = errorMsg
+			result = refundResult(req, done)
+		}
+
+		if err := s.repo.CompleteRefund(ctx, rf, status, errorMsg, result); err != nil {
+			log.Error("failed to complete refund", "error", err, "status", status)
+			return false, err
+		}
+
+		if status == domain.RefundStatusFailed {
+			log.Warn("provider rejected refund", "reason", *errorMsg)
+		} else {
+			log.Info("provider refunded payment", "amount", rf.AmountCents)
+		}
+	}
+
+	return last >= 0, nil
+}
+
+// checkRefundRequest makes sure stored refunds belong to the request that
+// reuses their key.
+func checkRefundRequest(req domain.RefundRequest, refunds []domain.Refund) error {
+	var total int64
+	for _, rf := range refunds {
+		if rf.BookingID != req.BookingID {
+			return fmt.Errorf("%w: booking %s", domain.ErrRefundKeyReused, rf.BookingID)
+		}
+		total += rf.AmountCents
+	}
+	if total != req.AmountCents {
+		return fmt.Errorf("%w: amount %d", domain.ErrRefundKeyReused, total)
+	}
+	return nil
+}
+
+func rejectRefund(req domain.RefundRequest, msg string) *domain.RefundResult {
+	return &domain.RefundResult{
+		BookingID:    req.BookingID,
+		RefundKey:    req.RefundKey,
+		Status:       domain.RefundStatusFailed,
+		ErrorMessage: msg,
+		Refunds:      []domain.Refund{},
+	}
+}
+
+// refundResult sums up the refunds of a request: it succeeded when every
+// refund did, AmountCents is what was given back.
+func refundResult(req domain.RefundRequest, refunds []domain.Refund) *domain.RefundResult {
+	result := &domain.RefundResult{
+		BookingID: req.BookingID,
+		RefundKey: req.RefundKey,
+		Status:    domain.RefundStatusSuccess,
+		Refunds:   refunds,
+	}
+
+	var errs []string
+	for _, rf := range refunds {
+		if result.PaymentID == "" {
+			result.PaymentID = rf.PaymentID
+		}
+		switch rf.Status {
+		case domain.RefundStatusSuccess:
+			result.AmountCents += rf.AmountCents
+		case domain.RefundStatusFailed:
+			result.Status = domain.RefundStatusFailed
+			if rf.ErrorMessage != nil {
+				errs = append(errs, *rf.ErrorMessage)
+			}
+		}
+	}
+	result.ErrorMessage = strings.Join(errs, "; ")
+
+	return result
+}
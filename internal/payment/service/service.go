@@ -2,40 +2,60 @@ package service
 
 import (
 	"context"
-	"crypto/rand"
+	"errors"
+	"fmt"
 	"github.com/squ1ky/flyte/internal/payment/domain"
+	"github.com/squ1ky/flyte/internal/payment/provider"
 	"github.com/squ1ky/flyte/internal/payment/repository"
 	"log/slog"
-	"math/big"
 	"time"
 )
 
-const (
-	ProbabilityBase   = 100
-	BankSuccessChance = 80
-	BankMinDelay      = 500 * time.Millisecond
-	BankMaxDelay      = 2000 * time.Millisecond
-)
+// errOutcomeUnknown means the provider did not say whether it authorized or
+// captured a payment.
+var errOutcomeUnknown = errors.New("payment provider outcome unknown")
 
 type PaymentService struct {
-	repo repository.PaymentRepository
-	log  *slog.Logger
+	repo     repository.PaymentRepository
+	provider provider.Provider
+	cfg      Config
+	log      *slog.Logger
+}
+
+type Config struct {
+	Recovery RecoveryConfig
 }
 
-func NewPaymentService(repo repository.PaymentRepository, log *slog.Logger) *PaymentService {
+// RecoveryConfig controls the resumption of payments stuck in PENDING. A
+// payment pending for longer than StuckAfter is resumed, one still stuck
+// after MaxAttempts resumptions is failed.
+type RecoveryConfig struct {
+	StuckAfter  time.Duration
+	MaxAttempts int
+}
+
+func NewPaymentService(repo repository.PaymentRepository, provider provider.Provider, cfg Config, log *slog.Logger) *PaymentService {
 	return &PaymentService{
-		repo: repo,
-		log:  log,
+		repo:     repo,
+		provider: provider,
+		cfg:      cfg,
+		log:      log,
 	}
 }
 
-func (s *PaymentService) ProcessPayment(ctx context.Context, bookingID string, userID, amountCents int64, currency string) (*domain.Payment, error) {
+// ProcessPayment authorizes a payment request. The initial payment of a
+// booking stays AUTHORIZED until the booking captures or voids it, additional
+// charges pay for seats the customer already holds and are captured at once.
+// The result is published through the outbox.
+func (s *PaymentService) ProcessPayment(ctx context.Context, bookingID, reference string, userID, amountCents int64, currency string) error {
 	payment := &domain.Payment{
 		BookingID:   bookingID,
+		Reference:   reference,
 		UserID:      userID,
 		AmountCents: amountCents,
 		Currency:    currency,
 		Status:      domain.PaymentStatusPending,
+		Provider:    s.provider.Name(),
 	}
 
 	result, err := s.repo.CreateOrGet(ctx, payment)
@@ -43,73 +63,121 @@ func (s *PaymentService) ProcessPayment(ctx context.Context, bookingID string, u
 		s.log.Error("failed to create or get payment",
 			"error", err,
 			"booking_id", bookingID)
-		return nil, err
+		return err
 	}
 
-	currentPayment := result.Payment
-
 	if !result.IsNew {
-		s.log.Info("payment request duplicate, returning existing status",
+		// A finished payment's result is already in the outbox. A pending one
+		// is still being processed or was abandoned, the recovery worker
+		// finishes it once it is stuck.
+		s.log.Info("payment request duplicate, skipping",
 			"booking_id", bookingID,
-			"status", currentPayment.Status,
+			"status", result.Payment.Status,
 		)
-		return currentPayment, nil
+		return nil
 	}
 
-	s.simulateBankLatency()
-
-	var newStatus domain.PaymentStatus
-	var errorMsgPtr *string
+	return s.settle(ctx, result.Payment)
+}
 
-	if s.isBankSuccessful() {
-		newStatus = domain.PaymentStatusSuccess
-		s.log.Info("bank accepted payment", "booking_id", bookingID)
-	} else {
-		newStatus = domain.PaymentStatusFailed
-		msg := "insufficient funds or bank error"
-		errorMsgPtr = &msg
-		s.log.Warn("bank rejected payment",
-			"booking_id", bookingID,
-			"reason", msg)
+// settle authorizes a pending payment, captures it when it is an additional
+// charge and records the outcome together with the payment result.
+func (s *PaymentService) settle(ctx context.Context, p *domain.Payment) error {
+	status, errorMsg, err := s.authorize(ctx, p)
+	if errors.Is(err, errOutcomeUnknown) {
+		// The payment stays pending, the recovery worker asks again.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if status == domain.PaymentStatusAuthorized && p.Reference != "" {
+		status, errorMsg, err = s.capture(ctx, p)
+		if errors.Is(err, errOutcomeUnknown) {
+			// The payment stays pending with its authorization, the recovery
+			// worker authorizes again, which returns the same one, and
+			// captures.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	if err := s.repo.UpdateStatus(ctx, currentPayment.ID, newStatus, errorMsgPtr); err != nil {
-		s.log.Error("failed to update payment status",
+	if err := s.repo.CompletePayment(ctx, p, status, errorMsg); err != nil {
+		s.log.Error("failed to complete payment",
 			"error", err,
-			"booking_id", bookingID,
-			"status", newStatus)
-		return nil, err
+			"booking_id", p.BookingID,
+			"status", status)
+		return err
 	}
 
-	currentPayment.Status = newStatus
-	currentPayment.ErrorMessage = errorMsgPtr
-	now := time.Now()
-	currentPayment.ProcessedAt = &now
-
-	return currentPayment, nil
+	return nil
 }
 
-func (s *PaymentService) simulateBankLatency() {
-	delta := int64(BankMaxDelay - BankMinDelay)
-	if delta <= 0 {
-		time.Sleep(BankMinDelay)
-		return
+// authorize holds the payment's amount on the card. A decline fails the
+// payment, nothing is held then; any other error is errOutcomeUnknown.
+func (s *PaymentService) authorize(ctx context.Context, p *domain.Payment) (domain.PaymentStatus, *string, error) {
+	log := s.log.With("booking_id", p.BookingID, "payment_id", p.ID, "provider", s.provider.Name())
+
+	auth, err := s.provider.Authorize(ctx, provider.AuthorizeRequest{
+		PaymentID:   p.ID,
+		UserID:      p.UserID,
+		AmountCents: p.AmountCents,
+		Currency:    p.Currency,
+	})
+	if err != nil && !provider.IsDeclined(err) {
+		// A timeout or any other failure leaves the outcome unknown, an
+		// authorization may exist. Authorizing is idempotent per payment, so
+		// asking again later learns the outcome.
+		log.Warn("provider authorization outcome unknown, leaving payment pending", "error", err)
+		return "", nil, errOutcomeUnknown
+	}
+	if err != nil {
+		msg := err.Error()
+		log.Warn("provider rejected payment", "reason", msg)
+		// A resumed payment may hold an authorization from an earlier attempt.
+		if p.ProviderRef != nil {
+			s.void(ctx, log, *p.ProviderRef)
+		}
+		return domain.PaymentStatusFailed, &msg, nil
 	}
 
-	randomDuration := s.mustCryptoRandInt64(delta)
-	time.Sleep(BankMinDelay + time.Duration(randomDuration))
-}
+	if err := s.repo.SetProviderRef(ctx, p.ID, auth.ID); err != nil {
+		log.Error("failed to store authorization", "error", err)
+		s.void(ctx, log, auth.ID)
+		return "", nil, err
+	}
+	p.ProviderRef = &auth.ID
 
-func (s *PaymentService) isBankSuccessful() bool {
-	val := s.mustCryptoRandInt64(ProbabilityBase)
-	return val < int64(BankSuccessChance)
+	log.Info("provider authorized payment")
+	return domain.PaymentStatusAuthorized, nil, nil
 }
 
-func (s *PaymentService) mustCryptoRandInt64(max int64) int64 {
-	n, err := rand.Int(rand.Reader, big.NewInt(max))
+// capture takes the money held by an authorized payment. A decline voids the
+// authorization and fails the payment; any other error is errOutcomeUnknown,
+// the money may have been taken, and the payment is left as it is.
+func (s *PaymentService) capture(ctx context.Context, p *domain.Payment) (domain.PaymentStatus, *string, error) {
+	log := s.log.With("booking_id", p.BookingID, "payment_id", p.ID, "provider", s.provider.Name())
+
+	err := s.provider.Capture(ctx, *p.ProviderRef, p.AmountCents)
+	if err != nil && !provider.IsDeclined(err) {
+		log.Warn("provider capture outcome unknown, leaving payment authorized", "error", err)
+		return "", nil, fmt.Errorf("%w: %w", errOutcomeUnknown, err)
+	}
 	if err != nil {
-		s.log.Error("crypto/rand failed", "error", err)
-		return 0
+		msg := err.Error()
+		log.Warn("provider declined capture", "reason", msg)
+		s.void(ctx, log, *p.ProviderRef)
+		return domain.PaymentStatusFailed, &msg, nil
+	}
+
+	log.Info("provider captured payment")
+	return domain.PaymentStatusSuccess, nil, nil
+}
+
+func (s *PaymentService) void(ctx context.Context, log *slog.Logger, authorizationID string) {
+	if err := s.provider.Void(ctx, authorizationID); err != nil {
+		log.Error("failed to void authorization", "authorization_id", authorizationID, "error", err)
 	}
-	return n.Int64()
 }
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/squ1ky/flyte/internal/payment/domain"
+	"github.com/squ1ky/flyte/internal/payment/provider"
+)
+
+// ProcessCapture captures the authorized initial payment of a booking whose
+// seats are confirmed. A positive amountCents below the authorized amount
+// captures only that much, the booking got cheaper meanwhile. A payment
+// captured already is reported as captured again, so a redelivered request
+// gets the same answer. Every answer is published through the outbox.
+func (s *PaymentService) ProcessCapture(ctx context.Context, bookingID string, amountCents int64) (*domain.ActionResult, error) {
+	log := s.log.With("booking_id", bookingID)
+
+	payment, err := s.repo.GetByBookingID(ctx, bookingID)
+	if err != nil {
+		log.Error("failed to get payment for capture", "error", err)
+		return nil, err
+	}
+
+	result := &domain.ActionResult{Action: domain.PaymentActionCapture, BookingID: bookingID, PaymentID: payment.ID}
+
+	switch payment.Status {
+	case domain.PaymentStatusAuthorized:
+		if amountCents > payment.AmountCents {
+			result.Status = domain.PaymentStatusFailed
+			result.ErrorMessage = fmt.Sprintf("capture amount %d exceeds authorized amount %d", amountCents, payment.AmountCents)
+			log.Warn("capture rejected", "reason", result.ErrorMessage)
+			return s.answer(ctx, result)
+		}
+	case domain.PaymentStatusSuccess:
+		log.Info("capture request duplicate, payment already captured")
+		result.Status = domain.PaymentStatusSuccess
+		return s.answer(ctx, result)
+	default:
+		result.Status = domain.PaymentStatusFailed
+		result.ErrorMessage = fmt.Sprintf("payment is %s, only authorized payments can be captured", payment.Status)
+		log.Warn("capture rejected", "reason", result.ErrorMessage)
+		return s.answer(ctx, result)
+	}
+
+	if amountCents > 0 && amountCents < payment.AmountCents {
+		log.Info("capturing part of the authorization", "authorized", payment.AmountCents, "amount", amountCents)
+		payment.AmountCents = amountCents
+	}
+
+	status, errorMsg, err := s.capture(ctx, payment)
+	if err != nil {
+		// Redelivering the request captures again, the payment is still
+		// authorized.
+		log.Warn("capture not settled, will retry", "error", err)
+		return nil, err
+	}
+	result.Status = status
+	if errorMsg != nil {
+		result.ErrorMessage = *errorMsg
+	}
+
+	if status == domain.PaymentStatusSuccess {
+		err = s.repo.CapturePayment(ctx, payment.ID, payment.AmountCents, result)
+	} else {
+		err = s.repo.UpdateStatus(ctx, payment.ID, domain.PaymentStatusAuthorized, status, errorMsg, result)
+	}
+	if err != nil {
+		log.Error("failed to update payment status", "error", err, "status", status)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ProcessVoid releases the money held for the initial payment of a booking
+// that cannot go on. Nothing is held for a failed or voided payment, and a
+// payment captured in the meantime is refunded instead.
+func (s *PaymentService) ProcessVoid(ctx context.Context, bookingID, reason string) (*domain.ActionResult, error) {
+	log := s.log.With("booking_id", bookingID)
+
+	payment, err := s.repo.GetByBookingID(ctx, bookingID)
+	if err != nil {
+		log.Error("failed to get payment for void", "error", err)
+		return nil, err
+	}
+
+	result := &domain.ActionResult{Action: domain.PaymentActionVoid, BookingID: bookingID, PaymentID: payment.ID}
+
+	switch payment.Status {
+	case domain.PaymentStatusAuthorized:
+	case domain.PaymentStatusFailed, domain.PaymentStatusVoided, domain.PaymentStatusRefunded:
+		log.Info("void request for released payment", "status", payment.Status)
+		result.Status = domain.PaymentStatusSuccess
+		return s.answer(ctx, result)
+	case domain.PaymentStatusSuccess:
+		log.Info("void request for captured payment, refunding")
+		refund, err := s.ProcessRefund(ctx, domain.RefundRequest{
+			BookingID:   bookingID,
+			RefundKey:   bookingID + ":void",
+			AmountCents: payment.AmountCents,
+			Reason:      reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.Status = domain.PaymentStatusSuccess
+		if refund.Status != domain.RefundStatusSuccess {
+			result.Status = domain.PaymentStatusFailed
+			result.ErrorMessage = refund.ErrorMessage
+		}
+		return s.answer(ctx, result)
+	default:
+		result.Status = domain.PaymentStatusFailed
+		result.ErrorMessage = fmt.Sprintf("payment is %s, only authorized payments can be voided", payment.Status)
+		log.Warn("void rejected", "reason", result.ErrorMessage)
+		return s.answer(ctx, result)
+	}
+
+	if err := s.provider.Void(ctx, *payment.ProviderRef); err != nil {
+		if !provider.IsDeclined(err) {
+			log.Error("failed to void payment", "error", err)
+			return nil, err
+		}
+		result.Status = domain.PaymentStatusFailed
+		result.ErrorMessage = err.Error()
+		log.Warn("provider rejected void", "reason", result.ErrorMessage)
+		return s.answer(ctx, result)
+	}
+
+	var errorMsg *string
+	if reason != "" {
+		errorMsg = &reason
+	}
+	result.Status = domain.PaymentStatusSuccess
+	err = s.repo.UpdateStatus(ctx, payment.ID, domain.PaymentStatusAuthorized, domain.PaymentStatusVoided, errorMsg, result)
+	if err != nil {
+		log.Error("failed to update payment status", "error", err)
+		return nil, err
+	}
+
+	log.Info("provider voided payment", "reason", reason)
+	return result, nil
+}
+
+// answer queues the result of a capture or void request that changed no
+// payment.
+func (s *PaymentService) answer(ctx context.Context, result *domain.ActionResult) (*domain.ActionResult, error) {
+	if err := s.repo.QueueActionResult(ctx, result); err != nil {
+		s.log.Error("failed to queue payment action result", "error", err, "booking_id", result.BookingID)
+		return nil, err
+	}
+	return result, nil
+}
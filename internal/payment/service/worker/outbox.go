@@ -0,0 +1,22 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/squ1ky/flyte/pkg/outbox"
+)
+
+// BookingKey keys payment results by booking so that all results of one
+// booking land in the same partition.
+func BookingKey(event outbox.Event) ([]byte, error) {
+	var data struct {
+		BookingID string `json:"booking_id"`
+	}
+	if err := json.Unmarshal(event.Payload, &data); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	if data.BookingID == "" {
+		return nil, fmt.Errorf("event has no booking id")
+	}
+	return []byte(data.BookingID), nil
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// PaymentRecoverer resumes payments stuck in PENDING.
+type PaymentRecoverer interface {
+	RecoverPayments(ctx context.Context, limit int) (int, error)
+}
+
+// RecoveryWorker finishes the payments nobody else finishes: those whose
+// processing stopped halfway and whose request will not come back.
+type RecoveryWorker struct {
+	payments  PaymentRecoverer
+	log       *slog.Logger
+	interval  time.Duration
+	batchSize int
+}
+
+func NewRecoveryWorker(payments PaymentRecoverer, log *slog.Logger, interval time.Duration, batchSize int) *RecoveryWorker {
+	return &RecoveryWorker{
+		payments:  payments,
+		log:       log,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (w *RecoveryWorker) Start(ctx context.Context) {
+	w.log.Info("starting payment recovery worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.log.Info("stopping payment recovery worker")
+			return
+		case <-ticker.C:
+			// Drain the backlog before waiting for the next tick.
+			for {
+				n, err := w.payments.RecoverPayments(ctx, w.batchSize)
+				if err != nil {
+					w.log.Error("failed to recover payments", "error", err)
+					break
+				}
+				if n < w.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS seat_number        VARCHAR(10),
+    ADD COLUMN IF NOT EXISTS passenger_name     VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS passenger_passport VARCHAR(50);
+
+UPDATE bookings b
+SET seat_number        = i.seat_number,
+    passenger_name     = i.passenger_name,
+    passenger_passport = i.passenger_passport
+FROM (SELECT DISTINCT ON (booking_id) booking_id, seat_number, passenger_name, passenger_passport
+      FROM booking_items
+      ORDER BY booking_id, seat_number) i
+WHERE i.booking_id = b.id;
+
+DROP TABLE IF EXISTS booking_items;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS booking_items
+(
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    booking_id         UUID         NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+    seat_number        VARCHAR(10)  NOT NULL,
+
+    passenger_name     VARCHAR(255) NOT NULL,
+    passenger_passport VARCHAR(50)  NOT NULL,
+
+    price_cents        BIGINT       NOT NULL,
+
+    CONSTRAINT unique_booking_seat UNIQUE (booking_id, seat_number)
+);
+
+INSERT INTO booking_items (booking_id, seat_number, passenger_name, passenger_passport, price_cents)
+SELECT id, seat_number, passenger_name, passenger_passport, price_cents
+FROM bookings;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS seat_number,
+    DROP COLUMN IF EXISTS passenger_name,
+    DROP COLUMN IF EXISTS passenger_passport;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_booking_status_history_booking;
+DROP TABLE IF EXISTS booking_status_history;
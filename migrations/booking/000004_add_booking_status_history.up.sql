@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS booking_status_history
+(
+    id          BIGSERIAL PRIMARY KEY,
+    booking_id  UUID        NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status   VARCHAR(20) NOT NULL,
+    actor       VARCHAR(20) NOT NULL, -- 'USER', 'PAYMENT_EVENT', 'CLEANER_WORKER', 'SYSTEM'
+    actor_id    VARCHAR(64),
+    reason      TEXT        NOT NULL DEFAULT '',
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_status_history_booking ON booking_status_history (booking_id, created_at);
+
+INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, reason, created_at)
+SELECT id, NULL, status, 'SYSTEM', 'history backfilled', updated_at
+FROM bookings;
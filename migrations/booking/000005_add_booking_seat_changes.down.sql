@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_booking_seat_changes_booking;
+DROP TABLE IF EXISTS booking_seat_changes;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS booking_seat_changes
+(
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    booking_id      UUID        NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+    booking_item_id UUID        NOT NULL REFERENCES booking_items (id) ON DELETE CASCADE,
+
+    old_seat_number VARCHAR(10) NOT NULL,
+    new_seat_number VARCHAR(10) NOT NULL,
+    old_price_cents BIGINT      NOT NULL,
+    new_price_cents BIGINT      NOT NULL,
+
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_seat_changes_booking ON booking_seat_changes (booking_id, created_at);
@@ -0,0 +1,4 @@
+ALTER TABLE booking_items
+    DROP COLUMN IF EXISTS passenger_id,
+    DROP COLUMN IF EXISTS document_type,
+    DROP COLUMN IF EXISTS citizenship;
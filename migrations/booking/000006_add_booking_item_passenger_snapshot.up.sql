@@ -0,0 +1,4 @@
+ALTER TABLE booking_items
+    ADD COLUMN IF NOT EXISTS passenger_id  BIGINT,
+    ADD COLUMN IF NOT EXISTS document_type VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS citizenship   VARCHAR(3)  NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS unique_booking_idempotency_key;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS idempotency_key,
+    DROP COLUMN IF EXISTS request_hash;
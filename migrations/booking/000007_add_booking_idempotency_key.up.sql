@@ -0,0 +1,7 @@
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS request_hash    VARCHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_booking_idempotency_key
+    ON bookings (user_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS unique_booking_pnr;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS pnr;
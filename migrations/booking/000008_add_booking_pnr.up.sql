@@ -0,0 +1,31 @@
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS pnr VARCHAR(6);
+
+-- Backfill existing bookings with random locators from the same alphabet the
+-- service uses, retrying on the rare collision.
+DO
+$$
+    DECLARE
+        alphabet CONSTANT TEXT := 'ABCDEFGHJKLMNPQRSTUVWXYZ23456789';
+        b        RECORD;
+        code     TEXT;
+    BEGIN
+        FOR b IN SELECT id FROM bookings WHERE pnr IS NULL
+            LOOP
+                LOOP
+                    code := '';
+                    FOR i IN 1..6
+                        LOOP
+                            code := code || substr(alphabet, 1 + floor(random() * length(alphabet))::INT, 1);
+                        END LOOP;
+                    EXIT WHEN NOT EXISTS (SELECT 1 FROM bookings WHERE pnr = code);
+                END LOOP;
+                UPDATE bookings SET pnr = code WHERE id = b.id;
+            END LOOP;
+    END
+$$;
+
+ALTER TABLE bookings
+    ALTER COLUMN pnr SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_booking_pnr ON bookings (pnr);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bookings_user_created;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS departure_time;
@@ -0,0 +1,6 @@
+-- Departure time is copied from the flight service at booking time so the
+-- list can be filtered by it. Bookings made before this migration keep NULL.
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS departure_time TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_user_created ON bookings (user_id, created_at, id);
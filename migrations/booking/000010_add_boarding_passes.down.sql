@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_boarding_passes_booking;
+DROP TABLE IF EXISTS boarding_passes;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS boarding_passes
+(
+    booking_item_id UUID PRIMARY KEY REFERENCES booking_items (id) ON DELETE CASCADE,
+    booking_id      UUID    NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+    flight_id       BIGINT  NOT NULL,
+
+    -- Check-in sequence number printed on the pass, increments per flight.
+    sequence_number INTEGER NOT NULL,
+
+    issued_at       TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    CONSTRAINT unique_flight_sequence UNIQUE (flight_id, sequence_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_boarding_passes_booking ON boarding_passes (booking_id);
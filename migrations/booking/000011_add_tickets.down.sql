@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tickets_booking;
+DROP TABLE IF EXISTS tickets;
+DROP SEQUENCE IF EXISTS ticket_serial_seq;
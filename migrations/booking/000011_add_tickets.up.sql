@@ -0,0 +1,18 @@
+CREATE SEQUENCE IF NOT EXISTS ticket_serial_seq START 1;
+
+CREATE TABLE IF NOT EXISTS tickets
+(
+    id              BIGSERIAL PRIMARY KEY,
+    booking_id      UUID        NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+    booking_item_id UUID        NOT NULL REFERENCES booking_items (id) ON DELETE CASCADE,
+
+    -- 3-digit airline accounting code followed by a 10-digit serial number.
+    ticket_number   VARCHAR(13) NOT NULL UNIQUE,
+    coupon_number   SMALLINT    NOT NULL DEFAULT 1,
+
+    issued_at       TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    CONSTRAINT unique_item_coupon UNIQUE (booking_item_id, coupon_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tickets_booking ON tickets (booking_id);
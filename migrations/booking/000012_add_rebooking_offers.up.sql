@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS booking_rebooking_offers
+(
+    booking_id     UUID PRIMARY KEY REFERENCES bookings (id) ON DELETE CASCADE,
+
+    -- Next flight on the same route that had enough free seats when the
+    -- original flight was cancelled.
+    flight_id      BIGINT                   NOT NULL,
+    departure_time TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    status         VARCHAR(20)              NOT NULL DEFAULT 'OFFERED', -- 'OFFERED', 'ACCEPTED'
+    new_booking_id UUID REFERENCES bookings (id),
+
+    created_at     TIMESTAMP WITH TIME ZONE          DEFAULT NOW(),
+    updated_at     TIMESTAMP WITH TIME ZONE          DEFAULT NOW()
+);
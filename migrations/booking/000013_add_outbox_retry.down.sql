@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS idx_outbox_dead;
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_status ON booking_outbox (status, created_at) WHERE status = 'PENDING';
+
+ALTER TABLE booking_outbox
+    ADD COLUMN IF NOT EXISTS error_message TEXT;
+
+UPDATE booking_outbox
+SET error_message = last_error,
+    status        = CASE WHEN status = 'DEAD' THEN 'FAILED' ELSE status END;
+
+ALTER TABLE booking_outbox
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts;
@@ -0,0 +1,21 @@
+ALTER TABLE booking_outbox
+    ADD COLUMN IF NOT EXISTS attempts        INTEGER   NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS last_error      TEXT;
+
+UPDATE booking_outbox
+SET last_error = error_message
+WHERE error_message IS NOT NULL;
+
+-- FAILED only ever held events that could not be decoded, they are dead
+-- letters now and can be replayed from the admin API.
+UPDATE booking_outbox
+SET status = 'DEAD'
+WHERE status = 'FAILED';
+
+ALTER TABLE booking_outbox
+    DROP COLUMN IF EXISTS error_message;
+
+DROP INDEX IF EXISTS idx_outbox_status;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON booking_outbox (next_attempt_at, created_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_outbox_dead ON booking_outbox (created_at) WHERE status = 'DEAD';
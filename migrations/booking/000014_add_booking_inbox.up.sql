@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS booking_inbox
+(
+    consumer     VARCHAR(100) NOT NULL,
+    message_id   VARCHAR(255) NOT NULL,
+    effect_key   VARCHAR(100) NOT NULL DEFAULT '',
+    processed_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (consumer, message_id, effect_key)
+);
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS booking_sagas
+(
+    id              UUID PRIMARY KEY                  DEFAULT gen_random_uuid(),
+    -- Set once the booking is stored, sagas aborted while reserving seats
+    -- never get one.
+    booking_id      UUID UNIQUE REFERENCES bookings (id) ON DELETE CASCADE,
+    user_id         BIGINT                   NOT NULL,
+    flight_id       BIGINT                   NOT NULL,
+
+    state           VARCHAR(30)              NOT NULL, -- 'RESERVING_SEATS', 'AWAITING_PAYMENT', 'CONFIRMING_SEATS', 'COMPLETED', 'COMPENSATING', 'COMPENSATED'
+    reserved_seats  TEXT[]                   NOT NULL DEFAULT '{}',
+    failure_reason  TEXT,
+
+    attempts        INT                      NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    -- A step is due once this passes, running a step pushes it forward so no
+    -- other replica picks the same step up meanwhile.
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_sagas_due ON booking_sagas (next_attempt_at)
+    WHERE state IN ('RESERVING_SEATS', 'CONFIRMING_SEATS', 'COMPENSATING');
+
+CREATE INDEX IF NOT EXISTS idx_booking_sagas_state ON booking_sagas (state, created_at);
+
+-- Pending bookings made before sagas existed hold their seats already.
+INSERT INTO booking_sagas (booking_id, user_id, flight_id, state, reserved_seats)
+SELECT b.id,
+       b.user_id,
+       b.flight_id,
+       'AWAITING_PAYMENT',
+       ARRAY(SELECT i.seat_number FROM booking_items i WHERE i.booking_id = b.id ORDER BY i.seat_number)
+FROM bookings b
+WHERE b.status = 'PENDING';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_booking_seat_changes_awaiting;
+
+ALTER TABLE booking_seat_changes
+    DROP COLUMN IF EXISTS status;
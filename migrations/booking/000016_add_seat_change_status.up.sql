@@ -0,0 +1,6 @@
+-- A seat change that costs extra waits for its surcharge, the booking keeps
+-- the old seat until it is paid. Earlier changes were applied at once.
+ALTER TABLE booking_seat_changes
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'APPLIED';
+
+CREATE INDEX IF NOT EXISTS idx_booking_seat_changes_awaiting ON booking_seat_changes (booking_id) WHERE status = 'AWAITING_PAYMENT';
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_flight_outbox_dead;
+DROP INDEX IF EXISTS idx_flight_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_status_created ON flight_outbox (status, created_at);
+
+UPDATE flight_outbox
+SET status = 'FAILED'
+WHERE status = 'DEAD';
+
+ALTER TABLE flight_outbox
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts;
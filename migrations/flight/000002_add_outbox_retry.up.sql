@@ -0,0 +1,13 @@
+ALTER TABLE flight_outbox
+    ADD COLUMN IF NOT EXISTS attempts        INTEGER   NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS last_error      TEXT;
+
+-- FAILED events were never retried, they are dead letters now.
+UPDATE flight_outbox
+SET status = 'DEAD'
+WHERE status = 'FAILED';
+
+DROP INDEX IF EXISTS idx_outbox_status_created;
+CREATE INDEX IF NOT EXISTS idx_flight_outbox_pending ON flight_outbox (next_attempt_at, created_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_flight_outbox_dead ON flight_outbox (created_at) WHERE status = 'DEAD';
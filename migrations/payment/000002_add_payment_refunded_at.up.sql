@@ -0,0 +1,2 @@
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,10 @@
+ALTER TABLE payments
+    DROP CONSTRAINT IF EXISTS unique_booking_payment_reference;
+
+DELETE FROM payments WHERE reference <> '';
+
+ALTER TABLE payments
+    ADD CONSTRAINT payments_booking_id_key UNIQUE (booking_id);
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS reference;
@@ -0,0 +1,10 @@
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS reference VARCHAR(64) NOT NULL DEFAULT '';
+
+-- Additional charges (e.g. seat change surcharges) share the booking_id,
+-- so uniqueness moves to the (booking_id, reference) pair.
+ALTER TABLE payments
+    DROP CONSTRAINT IF EXISTS payments_booking_id_key;
+
+ALTER TABLE payments
+    ADD CONSTRAINT unique_booking_payment_reference UNIQUE (booking_id, reference);
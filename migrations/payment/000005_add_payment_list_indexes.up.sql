@@ -0,0 +1,4 @@
+-- Payments are listed per user by support and the gateway, and across all
+-- users by admins, both newest first.
+CREATE INDEX IF NOT EXISTS idx_payments_user_created ON payments (user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_payments_created ON payments (created_at, id);
@@ -0,0 +1,3 @@
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS provider_ref,
+    DROP COLUMN IF EXISTS provider;
@@ -0,0 +1,5 @@
+-- provider_ref is the provider's authorization, captures, voids and refunds
+-- of the payment go through it. Payments made before have none.
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS provider VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS provider_ref VARCHAR(128);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS refunds;
+
+ALTER TABLE payments
+    DROP CONSTRAINT IF EXISTS check_payment_refunded_cents;
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS refunded_cents;
@@ -0,0 +1,38 @@
+-- refunded_cents counts pending and successful refunds of a payment, so
+-- concurrent refunds can never add up to more than was captured.
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS refunded_cents BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE payments
+    ADD CONSTRAINT check_payment_refunded_cents CHECK (refunded_cents BETWEEN 0 AND amount_cents);
+
+CREATE TABLE IF NOT EXISTS refunds
+(
+    id            UUID PRIMARY KEY         DEFAULT gen_random_uuid(),
+    payment_id    UUID           NOT NULL REFERENCES payments (id),
+    booking_id    UUID           NOT NULL,
+    -- A refund request spread over several payments of a booking stores one
+    -- refund per payment under the same key.
+    refund_key    VARCHAR(128)   NOT NULL,
+    amount_cents  BIGINT         NOT NULL CHECK (amount_cents > 0),
+    currency      VARCHAR(3)     NOT NULL,
+    status        VARCHAR(20)    NOT NULL, -- 'PENDING', 'SUCCESS', 'FAILED'
+    reason        TEXT,
+    error_message TEXT,
+    created_at    TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    processed_at  TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT unique_refund_key_payment UNIQUE (refund_key, payment_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_booking_id ON refunds (booking_id, created_at);
+
+-- Payments refunded before refunds were tracked were refunded in full, once
+-- per booking. Their requests carried no key, the booking ID stands in.
+UPDATE payments SET refunded_cents = amount_cents WHERE status = 'REFUNDED';
+
+INSERT INTO refunds (payment_id, booking_id, refund_key, amount_cents, currency, status, created_at, processed_at)
+SELECT id, booking_id, booking_id::TEXT, amount_cents, currency, 'SUCCESS', refunded_at, refunded_at
+FROM payments
+WHERE status = 'REFUNDED'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_payments_pending;
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS recovered_at,
+    DROP COLUMN IF EXISTS recovery_attempts;
+
+DROP TABLE IF EXISTS payment_outbox;
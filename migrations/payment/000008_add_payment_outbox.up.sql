@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS payment_outbox
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    event_type      VARCHAR(50) NOT NULL,
+    payload         JSONB       NOT NULL,
+    status          VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    created_at      TIMESTAMP   NOT NULL DEFAULT NOW(),
+    processed_at    TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_outbox_pending ON payment_outbox (next_attempt_at, created_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_payment_outbox_dead ON payment_outbox (created_at) WHERE status = 'DEAD';
+
+-- A payment left PENDING is resumed by the recovery worker, recovered_at
+-- postpones the next attempt while one is running.
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS recovery_attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS recovered_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_payments_pending ON payments (created_at) WHERE status = 'PENDING';
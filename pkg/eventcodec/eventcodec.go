@@ -0,0 +1,176 @@
+// Package eventcodec encodes and decodes the Kafka events defined in
+// protos/events. Every message carries its event type, schema version and
+// content type in headers. Consumers reject major versions they do not know
+// and still read the JSON messages published before the protobuf contracts.
+package eventcodec
+
+import (
+	"errors"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	eventsv1 "github.com/squ1ky/flyte/gen/go/events"
+	"github.com/squ1ky/flyte/pkg/outbox"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"strings"
+)
+
+const (
+	HeaderEventType     = "event_type"
+	HeaderSchemaVersion = "schema_version"
+	HeaderContentType   = "content_type"
+
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+// SchemaVersion is the version of the contracts in protos/events. Bump the
+// minor version for backward compatible changes, such as a new field, and
+// the major version for anything consumers cannot read.
+const SchemaVersion = "1.2"
+
+const supportedMajor = 1
+
+const (
+	EventTypePaymentRequest = "PAYMENT_REQUEST"
+	EventTypePaymentResult  = "PAYMENT_RESULT"
+	EventTypeRefundRequest  = "REFUND_REQUEST"
+	EventTypeRefundResult   = "REFUND_RESULT"
+
+	EventTypePaymentCaptureRequest = "PAYMENT_CAPTURE_REQUEST"
+	EventTypePaymentCaptureResult  = "PAYMENT_CAPTURE_RESULT"
+	EventTypePaymentVoidRequest    = "PAYMENT_VOID_REQUEST"
+	EventTypePaymentVoidResult     = "PAYMENT_VOID_RESULT"
+
+	EventTypeFlightCancelled = "FLIGHT_CANCELLED"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported schema version")
+	ErrUnknownEventType   = errors.New("unknown event type")
+)
+
+var contracts = map[string]func() proto.Message{
+	EventTypePaymentRequest: func() proto.Message { return &eventsv1.PaymentRequest{} },
+	EventTypePaymentResult:  func() proto.Message { return &eventsv1.PaymentResult{} },
+	EventTypeRefundRequest:  func() proto.Message { return &eventsv1.RefundRequest{} },
+	EventTypeRefundResult:   func() proto.Message { return &eventsv1.PaymentResult{} },
+
+	EventTypePaymentCaptureRequest: func() proto.Message { return &eventsv1.PaymentCaptureRequest{} },
+	EventTypePaymentCaptureResult:  func() proto.Message { return &eventsv1.PaymentResult{} },
+	EventTypePaymentVoidRequest:    func() proto.Message { return &eventsv1.PaymentVoidRequest{} },
+	EventTypePaymentVoidResult:     func() proto.Message { return &eventsv1.PaymentResult{} },
+
+	EventTypeFlightCancelled: func() proto.Message { return &eventsv1.FlightCancelled{} },
+}
+
+// Headers returns the headers of an event encoded with Marshal.
+func Headers(eventType string) []kafka.Header {
+	return []kafka.Header{
+		{Key: HeaderEventType, Value: []byte(eventType)},
+		{Key: HeaderSchemaVersion, Value: []byte(SchemaVersion)},
+		{Key: HeaderContentType, Value: []byte(ContentTypeProtobuf)},
+	}
+}
+
+// Marshal encodes msg as the value of a Kafka message.
+func Marshal(msg proto.Message) ([]byte, error) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().Name(), err)
+	}
+	return data, nil
+}
+
+// Unmarshal decodes the value of m into msg. Messages without a content type
+// header predate the protobuf contracts and are read as JSON, their field
+// names are the same.
+func Unmarshal(m kafka.Message, msg proto.Message) error {
+	if err := checkVersion(headerValue(m, HeaderSchemaVersion)); err != nil {
+		return err
+	}
+
+	switch ct := headerValue(m, HeaderContentType); ct {
+	case ContentTypeProtobuf:
+		if err := proto.Unmarshal(m.Value, msg); err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %w", msg.ProtoReflect().Descriptor().Name(), err)
+		}
+	case ContentTypeJSON, "":
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(m.Value, msg); err != nil {
+			return fmt.Errorf("failed to unmarshal legacy %s: %w", msg.ProtoReflect().Descriptor().Name(), err)
+		}
+	default:
+		return fmt.Errorf("unsupported content type %q", ct)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes msg the way events are stored in outbox tables.
+func MarshalJSON(msg proto.Message) ([]byte, error) {
+	data, err := (protojson.MarshalOptions{UseProtoNames: true}).Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s to json: %w", msg.ProtoReflect().Descriptor().Name(), err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON decodes an outbox payload into msg. Unlike legacy messages
+// read from Kafka, unknown fields are an error here: the payload was written
+// by this code base and must match the contract.
+func UnmarshalJSON(data []byte, msg proto.Message) error {
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("failed to unmarshal %s from json: %w", msg.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
+
+// FromJSON converts an event stored as JSON into its protobuf encoding.
+func FromJSON(eventType string, data []byte) ([]byte, error) {
+	newMsg, ok := contracts[eventType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, eventType)
+	}
+
+	msg := newMsg()
+	if err := UnmarshalJSON(data, msg); err != nil {
+		return nil, err
+	}
+	return Marshal(msg)
+}
+
+func checkVersion(version string) error {
+	if version == "" {
+		return nil
+	}
+
+	major, _, _ := strings.Cut(version, ".")
+	n, err := strconv.Atoi(major)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrUnsupportedVersion, version)
+	}
+	if n != supportedMajor {
+		return fmt.Errorf("%w: %s, supported %d.x", ErrUnsupportedVersion, version, supportedMajor)
+	}
+	return nil
+}
+
+func headerValue(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// OutboxEncoder publishes outbox events stored as JSON in their protobuf
+// encoding, it plugs into outbox.KafkaSink.
+func OutboxEncoder(event outbox.Event) ([]byte, []kafka.Header, error) {
+	value, err := FromJSON(event.EventType, event.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, Headers(event.EventType), nil
+}
@@ -0,0 +1,136 @@
+// Package inbox makes the effects of consumed messages happen exactly once.
+// The consumer puts the message ID in the context, the repository records it
+// in the transaction of the business update, and a redelivered message fails
+// that transaction with ErrDuplicate instead of applying the update again.
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/segmentio/kafka-go"
+	"github.com/squ1ky/flyte/pkg/kafkaretry"
+	"log/slog"
+	"regexp"
+	"strconv"
+)
+
+// HeaderMessageID carries a producer-assigned ID that stays the same when
+// the message is published again.
+const HeaderMessageID = "message_id"
+
+var ErrDuplicate = errors.New("message already processed")
+
+// Message identifies a consumed message.
+type Message struct {
+	Consumer string
+	ID       string
+}
+
+type contextKey struct{}
+
+// WithMessage returns a context carrying the message being processed by
+// consumer.
+func WithMessage(ctx context.Context, consumer, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, Message{Consumer: consumer, ID: id})
+}
+
+// WithoutMessage returns ctx without the message it carries, for follow-up
+// work started by a message that is idempotent on its own and must not be
+// recorded as the message's effect.
+func WithoutMessage(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, nil)
+}
+
+// FromContext returns the message ctx carries, if any.
+func FromContext(ctx context.Context) (Message, bool) {
+	msg, ok := ctx.Value(contextKey{}).(Message)
+	return msg, ok
+}
+
+// MessageID returns the ID of a Kafka message: the message_id header when
+// the producer set one, otherwise the position the message was first
+// written at, which survives moves to retry topics.
+func MessageID(m kafka.Message) string {
+	var topic, partition, offset string
+	for _, h := range m.Headers {
+		switch h.Key {
+		case HeaderMessageID:
+			return string(h.Value)
+		case kafkaretry.HeaderOriginalTopic:
+			topic = string(h.Value)
+		case kafkaretry.HeaderOriginalPartition:
+			partition = string(h.Value)
+		case kafkaretry.HeaderOriginalOffset:
+			offset = string(h.Value)
+		}
+	}
+
+	if topic == "" {
+		topic, partition, offset = m.Topic, strconv.Itoa(m.Partition), strconv.FormatInt(m.Offset, 10)
+	}
+	return fmt.Sprintf("%s/%s/%s", topic, partition, offset)
+}
+
+// Wrap runs handle with the message in the context under consumer and treats
+// a message that was already processed as done.
+func Wrap(consumer string, handle kafkaretry.Handler, log *slog.Logger) kafkaretry.Handler {
+	return func(ctx context.Context, m kafka.Message) error {
+		id := MessageID(m)
+		err := handle(WithMessage(ctx, consumer, id), m)
+		if errors.Is(err, ErrDuplicate) {
+			log.Info("duplicate message skipped", "message_id", id, "topic", m.Topic, "offset", m.Offset)
+			return nil
+		}
+		return err
+	}
+}
+
+var tableName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// Inbox records processed messages in one table, see the services'
+// migrations for its columns.
+type Inbox struct {
+	table string
+}
+
+func New(table string) *Inbox {
+	if !tableName.MatchString(table) {
+		panic(fmt.Sprintf("inbox: invalid table name %q", table))
+	}
+	return &Inbox{table: table}
+}
+
+// Record marks the message ctx carries as processed inside tx and returns
+// ErrDuplicate when it already was. key tells apart the effects of a message
+// that updates several aggregates in separate transactions, each of them is
+// recorded on its own. Without a message in ctx, e.g. for API calls, Record
+// does nothing.
+func (i *Inbox) Record(ctx context.Context, tx *sqlx.Tx, key string) error {
+	msg, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (consumer, message_id, effect_key)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`, i.table)
+
+	result, err := tx.ExecContext(ctx, query, msg.Consumer, msg.ID, key)
+	if err != nil {
+		return fmt.Errorf("failed to record inbox message: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrDuplicate
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package kafkaretry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Handler processes one message. Returning an error retries the message,
+// wrap it with Permanent to dead-letter it right away.
+type Handler func(ctx context.Context, m kafka.Message) error
+
+type Config struct {
+	Brokers []string
+	Topic   string
+	GroupID string
+	// Delays are the retry tiers: a failed message waits Delays[0] before its
+	// first retry, Delays[1] before the second and so on. With no delays
+	// failed messages go straight to the dead-letter topic.
+	Delays []time.Duration
+}
+
+// tier is the main topic (delay 0) or one of its retry topics.
+type tier struct {
+	topic  string
+	delay  time.Duration
+	reader *kafka.Reader
+}
+
+type Consumer struct {
+	cfg     Config
+	tiers   []tier
+	writer  *kafka.Writer
+	handler Handler
+	log     *slog.Logger
+}
+
+func NewConsumer(cfg Config, handler Handler, log *slog.Logger) *Consumer {
+	topics := []string{cfg.Topic}
+	delays := []time.Duration{0}
+	for _, delay := range cfg.Delays {
+		topics = append(topics, RetryTopic(cfg.Topic, cfg.GroupID, delay))
+		delays = append(delays, delay)
+	}
+
+	tiers := make([]tier, len(topics))
+	for i, topic := range topics {
+		tiers[i] = tier{
+			topic: topic,
+			delay: delays[i],
+			reader: kafka.NewReader(kafka.ReaderConfig{
+				Brokers:  cfg.Brokers,
+				Topic:    topic,
+				GroupID:  cfg.GroupID,
+				MinBytes: 10e3,
+				MaxBytes: 10e6,
+			}),
+		}
+	}
+
+	return &Consumer{
+		cfg:   cfg,
+		tiers: tiers,
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(cfg.Brokers...),
+			Balancer:               &kafka.Hash{},
+			AllowAutoTopicCreation: true,
+		},
+		handler: handler,
+		log:     log.With("topic", cfg.Topic),
+	}
+}
+
+// Start consumes the main topic and every retry topic until ctx is done.
+func (c *Consumer) Start(ctx context.Context) error {
+	c.log.Info("starting kafka consumer", "retry_tiers", len(c.cfg.Delays))
+
+	var wg sync.WaitGroup
+	for _, t := range c.tiers {
+		wg.Add(1)
+		go func(t tier) {
+			defer wg.Done()
+			c.consume(ctx, t)
+		}(t)
+	}
+	wg.Wait()
+
+	c.log.Info("stopping kafka consumer")
+	return ctx.Err()
+}
+
+func (c *Consumer) consume(ctx context.Context, t tier) {
+	for {
+		m, err := t.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.log.Error("failed to fetch message", "from", t.topic, "error", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		// Messages of a tier share the delay, so they are due in the order
+		// they were written and waiting on the first one holds up nothing.
+		if t.delay > 0 && !sleepUntil(ctx, notBefore(m)) {
+			return
+		}
+
+		if err := c.handler(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if !c.fail(ctx, m, err) {
+				return
+			}
+		}
+
+		if err := t.reader.CommitMessages(ctx, m); err != nil {
+			c.log.Error("failed to commit message", "from", t.topic, "offset", m.Offset, "error", err)
+		}
+	}
+}
+
+// fail moves m to the next retry tier or to the dead-letter topic. It keeps
+// trying until the message is written, committing it before would lose it.
+func (c *Consumer) fail(ctx context.Context, m kafka.Message, cause error) bool {
+	n := attempt(m)
+	log := c.log.With("from", m.Topic, "offset", m.Offset, "attempt", n, "error", cause)
+
+	var out kafka.Message
+	if IsPermanent(cause) || n >= len(c.cfg.Delays) {
+		out = failedMessage(m, DLQTopic(c.cfg.Topic, c.cfg.GroupID), n, cause)
+		log.Error("message dead-lettered", "dlq", out.Topic)
+	} else {
+		delay := c.cfg.Delays[n]
+		out = failedMessage(m, RetryTopic(c.cfg.Topic, c.cfg.GroupID, delay), n+1, cause)
+		out.Headers = append(out.Headers, kafka.Header{
+			Key:   HeaderNotBefore,
+			Value: []byte(time.Now().Add(delay).UTC().Format(time.RFC3339Nano)),
+		})
+		log.Warn("failed to process message, will retry", "retry_in", delay)
+	}
+
+	for {
+		err := c.writer.WriteMessages(ctx, out)
+		if err == nil {
+			return true
+		}
+		c.log.Error("failed to forward failed message", "to", out.Topic, "error", err)
+		if !sleepUntil(ctx, time.Now().Add(time.Second)) {
+			return false
+		}
+	}
+}
+
+func sleepUntil(ctx context.Context, t time.Time) bool {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (c *Consumer) Close() error {
+	var errs []error
+	for _, t := range c.tiers {
+		if err := t.reader.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close %s reader: %w", t.topic, err))
+		}
+	}
+	if err := c.writer.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("close writer: %w", err))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,144 @@
+// Package kafkaretry runs a Kafka consumer with non-blocking retries. A
+// message that fails is moved to a retry topic with a delay, tier by tier,
+// and ends up in a dead-letter topic once every tier is used up. The
+// original message is committed right away, so one bad message never stalls
+// its partition and never disappears silently.
+package kafkaretry
+
+import (
+	"errors"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers added to retried and dead-lettered messages. The original headers
+// are kept as they are.
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderAttempt           = "x-retry-attempt"
+	HeaderNotBefore         = "x-retry-not-before"
+	HeaderError             = "x-error"
+	HeaderFailedAt          = "x-failed-at"
+)
+
+// RetryTopic is the topic holding messages of topic that group failed to
+// process and retries after delay.
+func RetryTopic(topic, group string, delay time.Duration) string {
+	return fmt.Sprintf("%s.%s.retry.%s", topic, group, formatDelay(delay))
+}
+
+// DLQTopic is the topic holding messages of topic that group gave up on.
+func DLQTopic(topic, group string) string {
+	return fmt.Sprintf("%s.%s.dlq", topic, group)
+}
+
+func formatDelay(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d >= time.Minute && d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%ds", d/time.Second)
+	default:
+		return fmt.Sprintf("%dms", d/time.Millisecond)
+	}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks a handler error as not worth retrying, the message goes
+// straight to the dead-letter topic. Use it for messages that cannot be
+// decoded or that nobody handles.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var pe *permanentError
+	return errors.As(err, &pe)
+}
+
+// isRetryHeader reports whether key is one of the headers this package
+// manages, as opposed to the producer's own headers.
+func isRetryHeader(key string) bool {
+	return strings.HasPrefix(key, "x-retry-") ||
+		strings.HasPrefix(key, "x-original-") ||
+		key == HeaderError ||
+		key == HeaderFailedAt
+}
+
+func headerValue(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// attempt is the number of retries m has gone through, 0 on the main topic.
+func attempt(m kafka.Message) int {
+	n, err := strconv.Atoi(headerValue(m, HeaderAttempt))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// notBefore is when a retried message is due, zero when it has no delay.
+func notBefore(m kafka.Message) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, headerValue(m, HeaderNotBefore))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// failedMessage copies m for another topic, keeping the producer's headers
+// and where the message originally came from.
+func failedMessage(m kafka.Message, topic string, attempt int, cause error) kafka.Message {
+	headers := make([]kafka.Header, 0, len(m.Headers)+7)
+	for _, h := range m.Headers {
+		if !isRetryHeader(h.Key) {
+			headers = append(headers, h)
+		}
+	}
+
+	origTopic, origPartition, origOffset := m.Topic, strconv.Itoa(m.Partition), strconv.FormatInt(m.Offset, 10)
+	if t := headerValue(m, HeaderOriginalTopic); t != "" {
+		origTopic = t
+		origPartition = headerValue(m, HeaderOriginalPartition)
+		origOffset = headerValue(m, HeaderOriginalOffset)
+	}
+
+	headers = append(headers,
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(origTopic)},
+		kafka.Header{Key: HeaderOriginalPartition, Value: []byte(origPartition)},
+		kafka.Header{Key: HeaderOriginalOffset, Value: []byte(origOffset)},
+		kafka.Header{Key: HeaderAttempt, Value: []byte(strconv.Itoa(attempt))},
+		kafka.Header{Key: HeaderError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+	)
+
+	return kafka.Message{
+		Topic:   topic,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	}
+}
@@ -0,0 +1,82 @@
+package kafkaretry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	"log/slog"
+	"time"
+)
+
+type RedriveConfig struct {
+	Brokers []string
+	// Topic is the dead-letter topic to drain.
+	Topic   string
+	GroupID string
+	// Limit caps the number of re-driven messages, 0 means no limit.
+	Limit int
+	// IdleTimeout ends the run once no message arrived for that long.
+	IdleTimeout time.Duration
+}
+
+// Redrive moves dead-lettered messages back to the topic they came from,
+// with the retry metadata stripped so they get a fresh set of attempts. It
+// returns the number of messages moved.
+func Redrive(ctx context.Context, cfg RedriveConfig, log *slog.Logger) (int, error) {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     cfg.Brokers,
+		Topic:       cfg.Topic,
+		GroupID:     cfg.GroupID,
+		StartOffset: kafka.FirstOffset,
+	})
+	defer reader.Close()
+
+	writer := &kafka.Writer{
+		Addr:     kafka.TCP(cfg.Brokers...),
+		Balancer: &kafka.Hash{},
+	}
+	defer writer.Close()
+
+	moved := 0
+	for cfg.Limit == 0 || moved < cfg.Limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, cfg.IdleTimeout)
+		m, err := reader.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+				return moved, nil
+			}
+			return moved, fmt.Errorf("failed to fetch message: %w", err)
+		}
+
+		target := headerValue(m, HeaderOriginalTopic)
+		if target == "" {
+			return moved, fmt.Errorf("message at offset %d has no %s header", m.Offset, HeaderOriginalTopic)
+		}
+
+		headers := make([]kafka.Header, 0, len(m.Headers))
+		for _, h := range m.Headers {
+			if !isRetryHeader(h.Key) {
+				headers = append(headers, h)
+			}
+		}
+
+		out := kafka.Message{Topic: target, Key: m.Key, Value: m.Value, Headers: headers}
+		if err := writer.WriteMessages(ctx, out); err != nil {
+			return moved, fmt.Errorf("failed to write message to %s: %w", target, err)
+		}
+		if err := reader.CommitMessages(ctx, m); err != nil {
+			return moved, fmt.Errorf("failed to commit message: %w", err)
+		}
+
+		log.Info("message re-driven",
+			"offset", m.Offset,
+			"to", target,
+			"attempts", headerValue(m, HeaderAttempt),
+			"error", headerValue(m, HeaderError))
+		moved++
+	}
+
+	return moved, nil
+}
@@ -0,0 +1,134 @@
+// Package leader runs a piece of work on exactly one replica at a time. The
+// replica holding a Postgres advisory lock is the leader; the lock lives on a
+// dedicated connection, so it is released as soon as the leader's process or
+// connection dies and another replica takes over on its next attempt.
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type Elector struct {
+	db       *sqlx.DB
+	name     string
+	key      int64
+	interval time.Duration
+	log      *slog.Logger
+}
+
+// New returns an elector for the work called name, replicas agree on the
+// leader of every name on their own. interval is how often followers try
+// to take the lock and how often the leader checks it still holds it.
+func New(db *sqlx.DB, name string, interval time.Duration, log *slog.Logger) *Elector {
+	return &Elector{
+		db:       db,
+		name:     name,
+		key:      lockKey(name),
+		interval: interval,
+		log:      log.With("leader_election", name),
+	}
+}
+
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+// Run blocks until ctx is done. Whenever this replica becomes the leader it
+// calls work with a context that is cancelled once leadership is lost, and
+// waits for work to return before trying again.
+func (e *Elector) Run(ctx context.Context, work func(ctx context.Context)) {
+	for {
+		conn, err := e.acquire(ctx)
+		if err != nil {
+			e.log.Error("failed to run leader election", "error", err)
+		}
+		if conn != nil {
+			e.lead(ctx, conn, work)
+		}
+
+		if !sleep(ctx, e.interval) {
+			return
+		}
+	}
+}
+
+// acquire returns the connection holding the lock, or nil when another
+// replica is the leader.
+func (e *Elector) acquire(ctx context.Context) (*sql.Conn, error) {
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, e.key).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to try advisory lock: %w", err)
+	}
+	if !locked {
+		conn.Close()
+		return nil, nil
+	}
+
+	return conn, nil
+}
+
+func (e *Elector) lead(ctx context.Context, conn *sql.Conn, work func(ctx context.Context)) {
+	e.log.Info("became leader")
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		work(leaderCtx)
+	}()
+
+	// A broken connection means Postgres has dropped the lock and another
+	// replica may already lead, stop working as soon as that shows.
+	for sleep(leaderCtx, e.interval) {
+		if err := conn.PingContext(leaderCtx); err != nil {
+			if leaderCtx.Err() == nil {
+				e.log.Error("lost leader connection", "error", err)
+			}
+			break
+		}
+	}
+	cancel()
+	wg.Wait()
+
+	// The connection goes back to the pool, the lock must not go with it.
+	unlockCtx, unlockCancel := context.WithTimeout(context.Background(), e.interval)
+	defer unlockCancel()
+	if _, err := conn.ExecContext(unlockCtx, `SELECT pg_advisory_unlock($1)`, e.key); err != nil {
+		e.log.Warn("failed to release advisory lock", "error", err)
+		// Discard the connection instead, closing it releases the lock.
+		conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+	conn.Close()
+
+	e.log.Info("stopped leading")
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,79 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	"time"
+)
+
+const (
+	// HeaderEventType carries the outbox event type on every Kafka message.
+	HeaderEventType = "event_type"
+	// HeaderMessageID carries the outbox event ID, consumers use it to skip
+	// events the relay published more than once.
+	HeaderMessageID = "message_id"
+)
+
+// KeyFunc picks the Kafka message key of an event, returning an error
+// dead-letters it.
+type KeyFunc func(event Event) ([]byte, error)
+
+// Encoder turns a stored event into the value and headers of a Kafka
+// message, returning an error dead-letters the event.
+type Encoder func(event Event) ([]byte, []kafka.Header, error)
+
+// RawJSON publishes the stored JSON payload unchanged, with the event type in
+// the event_type header.
+func RawJSON(event Event) ([]byte, []kafka.Header, error) {
+	return event.Payload, []kafka.Header{{Key: HeaderEventType, Value: []byte(event.EventType)}}, nil
+}
+
+// KafkaSink writes events to a topic. Every message also carries the event
+// ID in the message_id header.
+type KafkaSink struct {
+	writer *kafka.Writer
+	key    KeyFunc
+	encode Encoder
+}
+
+func NewKafkaSink(brokers []string, topic string, key KeyFunc, encode Encoder) *KafkaSink {
+	return &KafkaSink{
+		writer: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Topic:    topic,
+			Balancer: &kafka.Hash{},
+		},
+		key:    key,
+		encode: encode,
+	}
+}
+
+func (s *KafkaSink) Publish(ctx context.Context, event Event) error {
+	key, err := s.key(event)
+	if err != nil {
+		return Permanent(fmt.Errorf("failed to build message key: %w", err))
+	}
+
+	value, headers, err := s.encode(event)
+	if err != nil {
+		return Permanent(fmt.Errorf("failed to encode event: %w", err))
+	}
+
+	msg := kafka.Message{
+		Key:     key,
+		Value:   value,
+		Headers: append(headers, kafka.Header{Key: HeaderMessageID, Value: []byte(event.ID)}),
+		Time:    time.Now(),
+	}
+
+	if err := s.writer.WriteMessages(ctx, msg); err != nil {
+		return fmt.Errorf("failed to write message to kafka: %w", err)
+	}
+
+	return nil
+}
+
+func (s *KafkaSink) Close() error {
+	return s.writer.Close()
+}
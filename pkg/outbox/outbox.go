@@ -0,0 +1,72 @@
+// Package outbox implements the transactional outbox pattern: events are
+// written in the same database transaction as the state change that caused
+// them and a relay publishes them to a sink afterwards, retrying with
+// exponential backoff and dead-lettering events that keep failing.
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "PENDING"
+	StatusProcessed Status = "PROCESSED"
+	// StatusDead holds events that ran out of attempts or can never be
+	// published, they are only retried when replayed.
+	StatusDead Status = "DEAD"
+)
+
+// Event is one row of an outbox table. Every outbox table has the same
+// columns, see the services' migrations.
+type Event struct {
+	ID            string          `db:"id"`
+	EventType     string          `db:"event_type"`
+	Payload       json.RawMessage `db:"payload"`
+	Status        Status          `db:"status"`
+	Attempts      int             `db:"attempts"`
+	NextAttemptAt time.Time       `db:"next_attempt_at"`
+	LastError     *string         `db:"last_error"`
+	CreatedAt     time.Time       `db:"created_at"`
+}
+
+const columns = `id, event_type, payload, status, attempts, next_attempt_at, last_error, created_at`
+
+var ErrEventNotFound = errors.New("outbox event not found")
+
+var tableName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+func mustTable(table string) string {
+	if !tableName.MatchString(table) {
+		panic(fmt.Sprintf("outbox: invalid table name %q", table))
+	}
+	return table
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks a sink error as not worth retrying, the event is
+// dead-lettered right away. Use it for payloads that cannot be decoded or
+// event types nobody handles.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var pe *permanentError
+	return errors.As(err, &pe)
+}
@@ -0,0 +1,130 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"log/slog"
+	"time"
+)
+
+// RetryPolicy controls how often a failed event is retried. The delay
+// doubles with every attempt, starting at BaseBackoff and capped at
+// MaxBackoff, after MaxAttempts the event is dead-lettered.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Backoff returns the delay before the next attempt once attempts attempts
+// have failed.
+func (rp RetryPolicy) Backoff(attempts int) time.Duration {
+	delay := rp.BaseBackoff
+	for i := 1; i < attempts && delay < rp.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > rp.MaxBackoff {
+		delay = rp.MaxBackoff
+	}
+	return delay
+}
+
+type Config struct {
+	BatchSize int
+	Interval  time.Duration
+	Retry     RetryPolicy
+}
+
+// Relay polls an outbox table and hands due events to a sink. Events are
+// delivered at least once: the sink may see an event again if the relay
+// stops between publishing and committing.
+type Relay struct {
+	db    *sqlx.DB
+	store *Store
+	sink  Sink
+	cfg   Config
+	log   *slog.Logger
+}
+
+func NewRelay(db *sqlx.DB, table string, sink Sink, cfg Config, log *slog.Logger) *Relay {
+	return &Relay{
+		db:    db,
+		store: NewStore(db, table),
+		sink:  sink,
+		cfg:   cfg,
+		log:   log.With("outbox", table),
+	}
+}
+
+func (r *Relay) Start(ctx context.Context) {
+	r.log.Info("starting outbox relay", "interval", r.cfg.Interval, "batch_size", r.cfg.BatchSize)
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.log.Info("stopping outbox relay")
+			return
+		case <-ticker.C:
+			if err := r.ProcessBatch(ctx); err != nil {
+				r.log.Error("failed to process batch", "error", err)
+			}
+		}
+	}
+}
+
+// ProcessBatch publishes one batch of due events and records the outcome of
+// each in the same transaction that locked them.
+func (r *Relay) ProcessBatch(ctx context.Context) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	events, err := r.store.fetchDue(ctx, tx, r.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	for _, event := range events {
+		if err := r.sink.Publish(ctx, event); err != nil {
+			if err := r.handleFailure(ctx, tx, event, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := r.store.markProcessed(ctx, tx, event.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// handleFailure dead-letters events that can never be published or have
+// used up their attempts, everything else is retried with backoff.
+func (r *Relay) handleFailure(ctx context.Context, tx *sqlx.Tx, event Event, err error) error {
+	log := r.log.With("outbox_id", event.ID, "type", event.EventType)
+	attempts := event.Attempts + 1
+
+	if IsPermanent(err) || attempts >= r.cfg.Retry.MaxAttempts {
+		log.Error("outbox event is dead", "attempts", attempts, "error", err)
+		return r.store.markDead(ctx, tx, event.ID, err.Error())
+	}
+
+	delay := r.cfg.Retry.Backoff(attempts)
+	log.Warn("failed to publish outbox event, will retry", "attempts", attempts, "retry_in", delay, "error", err)
+	return r.store.scheduleRetry(ctx, tx, event.ID, delay, err.Error())
+}
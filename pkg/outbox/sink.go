@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Sink delivers an event somewhere outside the database. Returning an error
+// wrapped with Permanent dead-letters the event, any other error retries it.
+type Sink interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+type SinkFunc func(ctx context.Context, event Event) error
+
+func (f SinkFunc) Publish(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// Router sends every event type to its own sink, unknown types are dead.
+type Router map[string]Sink
+
+func (r Router) Publish(ctx context.Context, event Event) error {
+	sink, ok := r[event.EventType]
+	if !ok {
+		return Permanent(fmt.Errorf("no sink for event type %s", event.EventType))
+	}
+	return sink.Publish(ctx, event)
+}
+
+// Chain publishes to every sink in order and stops at the first error. A
+// retried event is published to all sinks again, so they must be idempotent.
+func Chain(sinks ...Sink) Sink {
+	return SinkFunc(func(ctx context.Context, event Event) error {
+		for _, sink := range sinks {
+			if err := sink.Publish(ctx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MemorySink keeps published events in memory, for local runs and tests.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (s *MemorySink) Publish(_ context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+// Events returns a copy of everything published so far.
+func (s *MemorySink) Events() []Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Event(nil), s.events...)
+}
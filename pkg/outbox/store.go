@@ -0,0 +1,117 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// Store reads and updates the events of one outbox table.
+type Store struct {
+	db    *sqlx.DB
+	table string
+}
+
+func NewStore(db *sqlx.DB, table string) *Store {
+	return &Store{db: db, table: mustTable(table)}
+}
+
+// fetchDue locks the pending events that are due, oldest first so that a
+// backlog drains in order. Locked rows are skipped, several relays can work
+// on the same table.
+func (s *Store) fetchDue(ctx context.Context, tx *sqlx.Tx, limit int) ([]Event, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE status = $1 AND next_attempt_at <= NOW()
+		ORDER BY created_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, columns, s.table)
+
+	var events []Event
+	if err := tx.SelectContext(ctx, &events, query, StatusPending, limit); err != nil {
+		return nil, fmt.Errorf("failed to fetch pending outbox events: %w", err)
+	}
+	return events, nil
+}
+
+func (s *Store) markProcessed(ctx context.Context, tx *sqlx.Tx, id string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1, processed_at = NOW(), attempts = attempts + 1, last_error = NULL
+		WHERE id = $2
+	`, s.table)
+	return exec(ctx, tx, "mark outbox event as processed", query, StatusProcessed, id)
+}
+
+// scheduleRetry counts a failed attempt and postpones the event. The due
+// time is computed by the database so it compares cleanly with NOW().
+func (s *Store) scheduleRetry(ctx context.Context, tx *sqlx.Tx, id string, delay time.Duration, reason string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET attempts = attempts + 1,
+		    next_attempt_at = NOW() + $1 * INTERVAL '1 millisecond',
+		    last_error = $2
+		WHERE id = $3
+	`, s.table)
+	return exec(ctx, tx, "schedule outbox retry", query, delay.Milliseconds(), reason, id)
+}
+
+func (s *Store) markDead(ctx context.Context, tx *sqlx.Tx, id string, reason string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1, attempts = attempts + 1, processed_at = NOW(), last_error = $2
+		WHERE id = $3
+	`, s.table)
+	return exec(ctx, tx, "mark outbox event as dead", query, StatusDead, reason, id)
+}
+
+// ListDead returns dead events, oldest first.
+func (s *Store) ListDead(ctx context.Context, limit int) ([]Event, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE status = $1
+		ORDER BY created_at, id
+		LIMIT $2
+	`, columns, s.table)
+
+	var events []Event
+	if err := s.db.SelectContext(ctx, &events, query, StatusDead, limit); err != nil {
+		return nil, fmt.Errorf("failed to fetch dead outbox events: %w", err)
+	}
+	if events == nil {
+		events = []Event{}
+	}
+	return events, nil
+}
+
+// Replay puts a dead event back in the queue with a fresh attempt budget,
+// the last error is kept until the next attempt.
+func (s *Store) Replay(ctx context.Context, id string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1, attempts = 0, next_attempt_at = NOW(), processed_at = NULL
+		WHERE id = $2 AND status = $3
+	`, s.table)
+	return exec(ctx, s.db, "replay outbox event", query, StatusPending, id, StatusDead)
+}
+
+func exec(ctx context.Context, db sqlx.ExecerContext, op, query string, args ...interface{}) error {
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", op, err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrEventNotFound
+	}
+
+	return nil
+}
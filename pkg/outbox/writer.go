@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Writer appends events to an outbox table inside the caller's transaction.
+type Writer struct {
+	table string
+}
+
+func NewWriter(table string) *Writer {
+	return &Writer{table: mustTable(table)}
+}
+
+// Write marshals payload to JSON and inserts it as a pending event.
+// Protobuf messages are marshalled with their proto field names.
+func (w *Writer) Write(ctx context.Context, tx *sqlx.Tx, eventType string, payload interface{}) error {
+	data, err := marshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox payload: %w", err)
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (event_type, payload) VALUES ($1, $2)`, w.table)
+	if _, err := tx.ExecContext(ctx, query, eventType, data); err != nil {
+		return fmt.Errorf("failed to insert outbox event: %w", err)
+	}
+
+	return nil
+}
+
+func marshalPayload(payload interface{}) ([]byte, error) {
+	if msg, ok := payload.(proto.Message); ok {
+		return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	}
+	return json.Marshal(payload)
+}